@@ -2,6 +2,7 @@ package gomatrix
 
 import (
 	"math/big"
+	"math/bits"
 )
 
 // AddMatrix adds two matrices
@@ -13,22 +14,51 @@ import (
 //
 // @return *F2|nil
 func (f *F2) AddMatrix(m *F2) *F2 {
+	// add the matrix and drop the error
+	result, err := f.AddMatrixChecked(m)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+// AddMatrixChecked adds two matrices
+//
+// This function works like AddMatrix, but it returns ErrDimensionMismatch
+// instead of nil if the sizes of the matrices are different.
+//
+// @param *F2 m The matrix to add
+//
+// @return *F2, error
+func (f *F2) AddMatrixChecked(m *F2) (*F2, error) {
 	// if the size is not equal...
 	if f.N != m.N || f.M != m.M {
 		// ...return an error
-		return nil
+		return nil, ErrDimensionMismatch
 	}
 
-	// iterate through the rows
-	for i := 0; i < f.N; i++ {
-		//  xor each row with the relating row of the second matrix
-		f.Rows[i].Xor(f.Rows[i], m.Rows[i])
-	}
+	// process the rows concurrently
+	parallelRows(f.N, func(start, stop int) {
+		// iterate through the rows
+		for i := start; i < stop; i++ {
+			//  xor each row with the relating row of the second matrix
+			f.Rows[i].Xor(f.Rows[i], m.Rows[i])
+		}
+	})
 
 	// return the matrix
-	return f
+	return f, nil
 }
 
+// m4rmThreshold is the dimension from which the method of four russians is
+// used for the multiplication
+const m4rmThreshold = 64
+
+// m4rmBits is the count of rows of the second matrix that are combined in
+// one table of the method of four russians
+const m4rmBits = 8
+
 // MulMatrix multiplies matrix f with matrix m
 //
 // This functions multiplies matrix fxm. M could be a Nx1 matrix for a vector.
@@ -36,46 +66,238 @@ func (f *F2) AddMatrix(m *F2) *F2 {
 // modified. If the multiplication was successful, the result is stored
 // in f and returned.
 //
+// Small matrices are multiplied column by column. If any dimension reaches
+// m4rmThreshold, the method of four russians is used instead.
+//
 // @param *F2 m The matrix that is used for the multiplication
 //
 // @return *F2
 func (f *F2) MulMatrix(m *F2) *F2 {
+	// multiply the matrices and drop the error
+	result, err := f.MulMatrixChecked(m)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+// MulMatrixChecked multiplies matrix f with matrix m
+//
+// This function works like MulMatrix, but it returns ErrDimensionMismatch
+// instead of nil if the matrices cannot be multiplied.
+//
+// @param *F2 m The matrix that is used for the multiplication
+//
+// @return *F2, error
+func (f *F2) MulMatrixChecked(m *F2) (*F2, error) {
 	// if the dimensions do not fit for a multiplication...
 	if f.M != m.N {
 		// ...return an error
-		return nil
+		return nil, ErrDimensionMismatch
 	}
 
+	// multiply the matrices
+	result := f.product(m)
+
+	// save the result matrix in f
+	f.N = result.N
+	f.M = result.M
+	f.Rows = result.Rows
+
+	// return the result
+	return result, nil
+}
+
+// product returns the product of f and m without modifying f
+//
+// Small matrices are multiplied column by column. If any dimension reaches
+// m4rmThreshold, the method of four russians is used instead.
+//
+// @param *F2 m The matrix that is used for the multiplication
+//
+// @return *F2
+func (f *F2) product(m *F2) *F2 {
+	// choose the algorithm depending on the size
+	if f.N < m4rmThreshold && f.M < m4rmThreshold && m.M < m4rmThreshold {
+		return f.mulNaive(m)
+	}
+
+	return f.mulM4RM(m)
+}
+
+// mulNaive multiplies matrix f with matrix m
+//
+// Each bit of the result is the sum of the bitwise product of a row of f and
+// a column of m. f is not modified.
+//
+// @param *F2 m The matrix that is used for the multiplication
+//
+// @return *F2
+func (f *F2) mulNaive(m *F2) *F2 {
 	// create the result matrix
 	result := NewF2(f.N, m.M)
 
-	// iterate through the rows of f
-	for i, row := range f.Rows {
-		// iterate through the columns of m
-		for j := 0; j < m.M; j++ {
-			// get the column from the second matrix
-			col := m.GetCol(j)
+	// process the rows of f concurrently
+	parallelRows(f.N, func(start, stop int) {
+		// iterate through the rows of f
+		for i := start; i < stop; i++ {
+			// iterate through the columns of m
+			for j := 0; j < m.M; j++ {
+				// get the column from the second matrix
+				col := m.GetCol(j)
 
-			// multiply the vectors
-			intermediateResult := big.NewInt(0).And(row, col)
+				// multiply the vectors
+				intermediateResult := big.NewInt(0).And(f.Rows[i], col)
 
-			// sum up the values of the vectors
-			resultBit := addBits(intermediateResult)
+				// sum up the values of the vectors
+				resultBit := addBits(intermediateResult)
 
-			// set the resulting bit to the result matrix
-			result.Rows[i].SetBit(result.Rows[i], j, resultBit)
+				// set the resulting bit to the result matrix
+				result.Rows[i].SetBit(result.Rows[i], j, resultBit)
+			}
 		}
-	}
+	})
 
-	// save the result matrix in f
-	f.N = result.N
-	f.M = result.M
-	f.Rows = result.Rows
+	return result
+}
+
+// mulM4RM multiplies matrix f with matrix m with the method of four russians
+//
+// The rows of m are split into blocks of m4rmBits rows. For each block, all
+// sums of its rows are precomputed in a table in gray code order, so that
+// each entry costs a single addition. The bits of a row of f that belong to
+// the block are the index of the sum to add to the relating row of the
+// result. f is not modified.
+//
+// @param *F2 m The matrix that is used for the multiplication
+//
+// @return *F2
+func (f *F2) mulM4RM(m *F2) *F2 {
+	// create the result matrix
+	result := NewF2(f.N, m.M)
+
+	// initialize the table, which is reused for all blocks
+	table := newGrayTable(m4rmBits)
+
+	// iterate through the blocks of rows of m
+	for k := 0; k < m.N; k += m4rmBits {
+		// get the size of the block
+		size := m4rmBits
+		if k+size > m.N {
+			size = m.N - k
+		}
+
+		// precompute all sums of the rows in the block
+		fillGrayTable(table, m.Rows[k:k+size])
+
+		// process the rows of f concurrently, the table is only read
+		parallelRows(f.N, func(start, stop int) {
+			// iterate through the rows of f
+			for i := start; i < stop; i++ {
+				// get the bits of the row that select the rows of the block
+				index := bitsAt(f.Rows[i], k, size)
+
+				// if no row is selected...
+				if index == 0 {
+					// ...skip the row
+					continue
+				}
+
+				// add the sum of the selected rows to the result
+				result.Rows[i].Xor(result.Rows[i], table[index])
+			}
+		})
+	}
 
-	// return the result
 	return result
 }
 
+// newGrayTable creates a table for the sums of up to n rows
+//
+// @param int n The maximum count of rows
+//
+// @return []*big.Int
+func newGrayTable(n int) []*big.Int {
+	// initialize the table
+	table := make([]*big.Int, 1<<uint(n))
+
+	// create an entry for each combination of the rows
+	for i := range table {
+		table[i] = big.NewInt(0)
+	}
+
+	return table
+}
+
+// fillGrayTable computes all sums of the given rows
+//
+// After this call, table[index] contains the sum of all rows whose bit is set
+// in index. The table is filled in gray code order, so that each entry only
+// differs in one row from the previous entry.
+//
+// @param []*big.Int table The table to fill, which needs 2^len(rows) entries
+// @param []*big.Int rows  The rows to combine
+func fillGrayTable(table []*big.Int, rows []*big.Int) {
+	// the empty sum is 0
+	table[0].SetInt64(0)
+
+	// initialize the previous gray code
+	previous := 0
+
+	// iterate through all combinations
+	for i := 1; i < 1<<uint(len(rows)); i++ {
+		// get the gray code of the counter
+		gray := i ^ (i >> 1)
+
+		// get the row that differs from the previous combination
+		changedRow := rows[bits.TrailingZeros(uint(i))]
+
+		// add the row to the previous combination
+		table[gray].Xor(table[previous], changedRow)
+
+		// continue with the next combination
+		previous = gray
+	}
+}
+
+// bitsAt returns n <= bits.UintSize bits of x, starting at offset
+//
+// @param *big.Int x      The number to read from
+// @param int      offset The first bit to read
+// @param int      n      The count of bits to read
+//
+// @return uint
+func bitsAt(x *big.Int, offset, n int) uint {
+	// get the words of the number
+	words := x.Bits()
+
+	// get the position of the first bit
+	index, shift := offset/bits.UintSize, uint(offset%bits.UintSize)
+
+	// if the bits are beyond the number...
+	if index >= len(words) {
+		// ...they are 0
+		return 0
+	}
+
+	// read the bits from the first word
+	value := uint(words[index]) >> shift
+
+	// if the bits overlap into the next word...
+	if int(shift)+n > bits.UintSize && index+1 < len(words) {
+		// ...read the remaining bits from it
+		value |= uint(words[index+1]) << (bits.UintSize - shift)
+	}
+
+	// mask out the requested bits
+	if n < bits.UintSize {
+		value &= 1<<uint(n) - 1
+	}
+
+	return value
+}
+
 // addBits sums up all bits of a given number
 //
 // @param *big.Int number The number to process
@@ -125,3 +347,153 @@ func PartialXor(x, y *big.Int, startCol, stopCol int) *big.Int {
 	// return the result
 	return big.NewInt(0).Xor(x, bitsToXor)
 }
+
+// Add returns the sum of the matrices a and b
+//
+// In contrast to AddMatrix, neither a nor b is modified. If the sizes of the
+// matrices are different, ErrDimensionMismatch is returned.
+//
+// @param *F2 a The first matrix
+// @param *F2 b The second matrix
+//
+// @return *F2, error
+func Add(a, b *F2) (*F2, error) {
+	return NewF2(0, 0).Add(a, b)
+}
+
+// Mul returns the product of the matrices a and b
+//
+// In contrast to MulMatrix, neither a nor b is modified. If the matrices
+// cannot be multiplied, ErrDimensionMismatch is returned.
+//
+// @param *F2 a The left matrix
+// @param *F2 b The right matrix
+//
+// @return *F2, error
+func Mul(a, b *F2) (*F2, error) {
+	return NewF2(0, 0).Mul(a, b)
+}
+
+// Transpose returns the transposed matrix of a
+//
+// In contrast to T, a is not modified.
+//
+// @param *F2 a The matrix to transpose
+//
+// @return *F2
+func Transpose(a *F2) *F2 {
+	return NewF2(0, 0).Transpose(a)
+}
+
+// Add stores the sum of the matrices a and b in f
+//
+// The rows of f are reused, so that repeated additions into the same
+// matrix do not allocate new rows. f may be the same matrix as a or b. If
+// the sizes of the matrices are different, ErrDimensionMismatch is returned
+// and f is not modified.
+//
+// @param *F2 a The first matrix
+// @param *F2 b The second matrix
+//
+// @return *F2, error
+func (f *F2) Add(a, b *F2) (*F2, error) {
+	// if the size is not equal...
+	if a.N != b.N || a.M != b.M {
+		// ...return an error
+		return nil, ErrDimensionMismatch
+	}
+
+	// prepare the rows of f
+	f.resize(a.N, a.M)
+
+	// process the rows concurrently
+	parallelRows(f.N, func(start, stop int) {
+		// iterate through the rows
+		for i := start; i < stop; i++ {
+			// xor the relating rows into the row of f
+			f.Rows[i].Xor(a.Rows[i], b.Rows[i])
+		}
+	})
+
+	return f, nil
+}
+
+// Mul stores the product of the matrices a and b in f
+//
+// The product is calculated like in MulMatrix and copied into the rows of f
+// afterwards, so f may be the same matrix as a or b. If the matrices cannot
+// be multiplied, ErrDimensionMismatch is returned and f is not modified.
+//
+// @param *F2 a The left matrix
+// @param *F2 b The right matrix
+//
+// @return *F2, error
+func (f *F2) Mul(a, b *F2) (*F2, error) {
+	// if the dimensions do not fit for a multiplication...
+	if a.M != b.N {
+		// ...return an error
+		return nil, ErrDimensionMismatch
+	}
+
+	// multiply the matrices and copy the result into f
+	f.copyRows(a.product(b))
+
+	return f, nil
+}
+
+// Transpose stores the transposed matrix of a in f
+//
+// f may be the same matrix as a.
+//
+// @param *F2 a The matrix to transpose
+//
+// @return *F2
+func (f *F2) Transpose(a *F2) *F2 {
+	// create the transposed rows
+	result := NewF2(a.M, a.N)
+
+	// iterate through the rows
+	for i, row := range a.Rows {
+		// transpose the row to a column
+		transposeRowToColumn(row, result.Rows, i)
+	}
+
+	// copy the result into f
+	f.copyRows(result)
+
+	return f
+}
+
+// resize changes the dimensions of f and makes sure that it has n rows
+//
+// Existing rows are kept, so that their storage can be reused. Missing rows
+// are created with the value 0.
+//
+// @param int n The count of rows
+// @param int m The count of columns
+func (f *F2) resize(n, m int) {
+	// shrink the rows or add new ones
+	if len(f.Rows) >= n {
+		f.Rows = f.Rows[:n]
+	}
+
+	for len(f.Rows) < n {
+		f.Rows = append(f.Rows, big.NewInt(0))
+	}
+
+	// save the dimensions
+	f.N, f.M = n, m
+}
+
+// copyRows copies the values of m into the rows of f
+//
+// @param *F2 m The matrix to copy
+func (f *F2) copyRows(m *F2) {
+	// prepare the rows of f
+	f.resize(m.N, m.M)
+
+	// copy the values into the existing rows
+	for i, row := range m.Rows {
+		f.Rows[i].Set(row)
+	}
+}
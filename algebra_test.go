@@ -136,3 +136,211 @@ func TestPartialXor(t *testing.T) {
 		assert.Equal(t, 0, test.expectedResult.Cmp(result))
 	}
 }
+
+func TestMulMatrixM4RM(t *testing.T) {
+	tests := []struct {
+		description string
+		matrixA     *F2
+		matrixB     *F2
+	}{
+		{
+			description: "square matrices",
+			matrixA:     randomF2(70, 70, 1),
+			matrixB:     randomF2(70, 70, 2),
+		},
+		{
+			description: "block size does not divide the inner dimension",
+			matrixA:     randomF2(13, 131, 3),
+			matrixB:     randomF2(131, 67, 4),
+		},
+		{
+			description: "vector",
+			matrixA:     randomF2(100, 100, 5),
+			matrixB:     randomF2(100, 1, 6),
+		},
+		{
+			description: "sparse matrix with zero rows",
+			matrixA:     NewF2(80, 80).SetToIdentity(),
+			matrixB:     randomF2(80, 90, 7),
+		},
+	}
+
+	for _, test := range tests {
+		expected := test.matrixA.mulNaive(test.matrixB)
+
+		result := test.matrixA.MulMatrix(test.matrixB)
+
+		assert.Truef(t, expected.IsEqual(result), test.description)
+		assert.Truef(t, expected.IsEqual(test.matrixA), test.description)
+	}
+}
+
+func TestFillGrayTable(t *testing.T) {
+	rows := []*big.Int{big.NewInt(1), big.NewInt(6), big.NewInt(12)}
+	table := newGrayTable(3)
+
+	fillGrayTable(table, rows)
+
+	for index, entry := range table {
+		expected := big.NewInt(0)
+
+		for i, row := range rows {
+			if index&(1<<uint(i)) != 0 {
+				expected.Xor(expected, row)
+			}
+		}
+
+		assert.Zero(t, expected.Cmp(entry))
+	}
+}
+
+func TestBitsAt(t *testing.T) {
+	x := new(big.Int).Lsh(big.NewInt(0x1ab), 60)
+
+	tests := []struct {
+		description    string
+		offset         int
+		n              int
+		expectedResult uint
+	}{
+		{
+			description:    "bits across words",
+			offset:         60,
+			n:              8,
+			expectedResult: 0xab,
+		},
+		{
+			description:    "lower bits",
+			offset:         0,
+			n:              8,
+			expectedResult: 0,
+		},
+		{
+			description:    "bits beyond the number",
+			offset:         200,
+			n:              8,
+			expectedResult: 0,
+		},
+		{
+			description:    "last bits of the number",
+			offset:         66,
+			n:              8,
+			expectedResult: 0x6,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equalf(t, test.expectedResult, bitsAt(x, test.offset, test.n), test.description)
+	}
+}
+
+func BenchmarkMulMatrix(b *testing.B) {
+	matrixA := randomF2(500, 500, 1)
+	matrixB := randomF2(500, 500, 2)
+
+	for i := 0; i < b.N; i++ {
+		NewF2(matrixA.N, matrixA.M).Set(matrixA.Rows).MulMatrix(matrixB)
+	}
+}
+
+func TestCheckedArithmetic(t *testing.T) {
+	matrix := randomF2(3, 4, 1)
+
+	_, err := NewF2(3, 4).Set(matrix.Rows).AddMatrixChecked(NewF2(3, 3))
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	_, err = NewF2(3, 4).Set(matrix.Rows).MulMatrixChecked(NewF2(3, 3))
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	_, err = NewF2(3, 4).Set(matrix.Rows).MulMatrixStrassenChecked(NewF2(3, 3), 0)
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	sum, err := NewF2(3, 4).Set(matrix.Rows).AddMatrixChecked(matrix)
+	assert.Nil(t, err)
+	assert.True(t, sum.IsEqual(NewF2(3, 4)))
+
+	identity := NewF2(4, 4).SetToIdentity()
+
+	product, err := NewF2(3, 4).Set(matrix.Rows).MulMatrixChecked(identity)
+	assert.Nil(t, err)
+	assert.True(t, product.IsEqual(matrix))
+
+	product, err = NewF2(3, 4).Set(matrix.Rows).MulMatrixStrassenChecked(identity, 0)
+	assert.Nil(t, err)
+	assert.True(t, product.IsEqual(matrix))
+}
+
+func TestPureArithmetic(t *testing.T) {
+	a := randomF2(70, 90, 1)
+	b := randomF2(70, 90, 2)
+	c := randomF2(90, 40, 3)
+
+	savedA := a.Clone()
+	savedB := b.Clone()
+	savedC := c.Clone()
+
+	sum, err := Add(a, b)
+	assert.Nil(t, err)
+	assert.True(t, sum.IsEqual(a.Clone().AddMatrix(b)))
+
+	product, err := Mul(a, c)
+	assert.Nil(t, err)
+	assert.True(t, product.IsEqual(a.Clone().MulMatrix(c)))
+
+	transposed := Transpose(a)
+	assert.True(t, transposed.IsEqual(a.Clone().T()))
+
+	// the operands are not modified
+	assert.True(t, a.IsEqual(savedA))
+	assert.True(t, b.IsEqual(savedB))
+	assert.True(t, c.IsEqual(savedC))
+
+	_, err = Add(a, c)
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	_, err = Mul(a, b)
+	assert.Equal(t, ErrDimensionMismatch, err)
+}
+
+func TestDestinationArithmetic(t *testing.T) {
+	a := randomF2(70, 90, 1)
+	b := randomF2(70, 90, 2)
+	c := randomF2(90, 90, 3)
+
+	// reuse the rows of the destination
+	dst := NewF2(70, 90)
+	row := dst.Rows[0]
+
+	result, err := dst.Add(a, b)
+	assert.Nil(t, err)
+	assert.Equal(t, dst, result)
+	assert.True(t, row == dst.Rows[0])
+	assert.True(t, dst.IsEqual(a.Clone().AddMatrix(b)))
+
+	// the destination can be an operand
+	expected := a.Clone().MulMatrix(c)
+
+	result, err = a.Mul(a, c)
+	assert.Nil(t, err)
+	assert.Equal(t, a, result)
+	assert.True(t, a.IsEqual(expected))
+
+	expected = Transpose(b)
+	assert.True(t, b.Transpose(b).IsEqual(expected))
+	assert.Equal(t, 90, b.N)
+	assert.Equal(t, 70, b.M)
+
+	// a destination with a different size is resized
+	dst = NewF2(3, 3)
+	_, err = dst.Mul(c, c)
+	assert.Nil(t, err)
+	assert.True(t, dst.IsEqual(c.Clone().MulMatrix(c)))
+
+	// the destination is not modified on errors
+	_, err = dst.Mul(NewF2(2, 3), NewF2(2, 3))
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	_, err = dst.Add(NewF2(2, 3), NewF2(3, 2))
+	assert.Equal(t, ErrDimensionMismatch, err)
+	assert.True(t, dst.IsEqual(c.Clone().MulMatrix(c)))
+}
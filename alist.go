@@ -0,0 +1,446 @@
+package gomatrix
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// ParseAlist reads a parity-check matrix in the alist format
+//
+// See ParseAlistSparse for the format.
+//
+// @param io.Reader r The reader to read the matrix from
+//
+// @return *F2, error
+func ParseAlist(r io.Reader) (*F2, error) {
+	// read the sparse matrix
+	s, err := ParseAlistSparse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ToF2(), nil
+}
+
+// ParseAlistSparse reads a parity-check matrix in the alist format
+//
+// The alist format of MacKay contains the count of columns and rows, the
+// maximal column and row weight, the weights of all columns, the weights of
+// all rows, the 1-based row indice of the ones in each column and the
+// 1-based column indice of the ones in each row. The lists may be padded
+// with zeros. The column and row lists need to describe the same matrix.
+// Malformed input is reported as *ParseError.
+//
+// @param io.Reader r The reader to read the matrix from
+//
+// @return *SparseF2, error
+func ParseAlistSparse(r io.Reader) (*SparseF2, error) {
+	// read the lines
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// read all numbers, the line breaks have no meaning
+	stream := &numberStream{lines: lines}
+	for i, line := range lines {
+		numbers, err := parseNumbers(line, i+1)
+		if err != nil {
+			return nil, err
+		}
+
+		stream.numbers = append(stream.numbers, numbers...)
+	}
+
+	// read the dimensions and the maximal weights
+	header, err := stream.nextN(4)
+	if err != nil {
+		return nil, err
+	}
+
+	m, n := header[0].value, header[1].value
+	maxColWeight, maxRowWeight := header[2].value, header[3].value
+
+	// read the weights of the columns and rows, since each column and row
+	// needs a weight, this also bounds the dimensions by the input
+	colWeights, err := stream.nextWeights(m, maxColWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	rowWeights, err := stream.nextWeights(n, maxRowWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// both lists need to contain the same count of ones
+	if sumInts(colWeights) != sumInts(rowWeights) {
+		return nil, &ParseError{
+			Line: header[0].line,
+			Col:  header[0].col,
+			Msg:  "column and row weights do not match",
+		}
+	}
+
+	// read the row indice of each column
+	cols, err := stream.nextLists(colWeights, n)
+	if err != nil {
+		return nil, err
+	}
+
+	// read the column indice of each row
+	s := NewSparseF2(n, m)
+	for i, weight := range rowWeights {
+		row := make([]int, 0, weight)
+
+		for len(row) < weight {
+			number, err := stream.nextIndex(m)
+			if err != nil {
+				return nil, err
+			}
+
+			// each one needs to be in the list of its column exactly once
+			j := number.value - 1
+			if containsInt(row, j) || !containsCol(cols[j], i) {
+				return nil, &ParseError{
+					Line: number.line,
+					Col:  number.col,
+					Msg:  "row and column lists do not match",
+				}
+			}
+
+			row = append(row, j)
+		}
+
+		sort.Ints(row)
+		s.Rows[i] = row
+	}
+
+	// only padding may follow the lists
+	if err := stream.end(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// WriteAlist writes the matrix in the alist format
+//
+// See SparseF2.WriteAlist for the format.
+//
+// @param io.Writer w The writer to write the matrix to
+//
+// @return error
+func (f *F2) WriteAlist(w io.Writer) error {
+	return f.ToSparse().WriteAlist(w)
+}
+
+// WriteAlist writes the matrix in the alist format
+//
+// The lists of the columns and rows are padded with zeros to the maximal
+// weight, like in the files of MacKay. ParseAlistSparse reads the matrix
+// back exactly.
+//
+// @param io.Writer w The writer to write the matrix to
+//
+// @return error
+func (s *SparseF2) WriteAlist(w io.Writer) error {
+	// collect the row indice of each column
+	cols := make([][]int, s.M)
+	for i, row := range s.Rows {
+		for _, j := range row {
+			cols[j] = append(cols[j], i)
+		}
+	}
+
+	// get the weights
+	colWeights := make([]int, s.M)
+	for j, col := range cols {
+		colWeights[j] = len(col)
+	}
+
+	rowWeights := make([]int, s.N)
+	for i, row := range s.Rows {
+		rowWeights[i] = len(row)
+	}
+
+	maxColWeight, maxRowWeight := maxInt(colWeights), maxInt(rowWeights)
+
+	// buffer the output, the writer keeps the first error
+	writer := bufio.NewWriter(w)
+
+	// write the header
+	writeNumbers(writer, []int{s.M, s.N}, 0)
+	writeNumbers(writer, []int{maxColWeight, maxRowWeight}, 0)
+	writeNumbers(writer, colWeights, 0)
+	writeNumbers(writer, rowWeights, 0)
+
+	// write the 1-based indice of the columns and rows
+	for _, col := range cols {
+		writeNumbers(writer, oneBased(col), maxColWeight)
+	}
+
+	for _, row := range s.Rows {
+		writeNumbers(writer, oneBased(row), maxRowWeight)
+	}
+
+	return writer.Flush()
+}
+
+// numberStream reads numbers across the lines of the input
+type numberStream struct {
+	lines   []string
+	numbers []numberToken
+	pos     int
+}
+
+// next returns the next number
+//
+// @return numberToken, error
+func (s *numberStream) next() (numberToken, error) {
+	// check if the input ended
+	if s.pos >= len(s.numbers) {
+		return numberToken{}, endOfInput(s.lines)
+	}
+
+	s.pos++
+
+	return s.numbers[s.pos-1], nil
+}
+
+// remaining returns the count of numbers that were not read yet
+//
+// @return int
+func (s *numberStream) remaining() int {
+	return len(s.numbers) - s.pos
+}
+
+// nextN returns the next k numbers
+//
+// @param int k The count of numbers
+//
+// @return []numberToken, error
+func (s *numberStream) nextN(k int) ([]numberToken, error) {
+	// check if the input ended
+	if k > s.remaining() {
+		return nil, endOfInput(s.lines)
+	}
+
+	s.pos += k
+
+	return s.numbers[s.pos-k : s.pos], nil
+}
+
+// nextWeights returns the next k weights that are at most max
+//
+// @param int k   The count of weights
+// @param int max The maximal weight
+//
+// @return []int, error
+func (s *numberStream) nextWeights(k, max int) ([]int, error) {
+	// read the weights
+	numbers, err := s.nextN(k)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify the weights
+	weights := make([]int, k)
+	for i, number := range numbers {
+		if number.value > max {
+			return nil, &ParseError{
+				Line: number.line,
+				Col:  number.col,
+				Msg:  "weight exceeds the maximal weight",
+			}
+		}
+
+		// each index of the list needs a number of the input
+		if number.value > len(s.numbers) {
+			return nil, &ParseError{
+				Line: number.line,
+				Col:  number.col,
+				Msg:  "weight exceeds the input",
+			}
+		}
+
+		weights[i] = number.value
+	}
+
+	return weights, nil
+}
+
+// nextIndex returns the next 1-based index and skips the padding zeros
+//
+// @param int max The largest valid index
+//
+// @return numberToken, error
+func (s *numberStream) nextIndex(max int) (numberToken, error) {
+	for {
+		number, err := s.next()
+		if err != nil {
+			return number, err
+		}
+
+		// skip the padding
+		if number.value == 0 {
+			continue
+		}
+
+		// verify the index
+		if number.value > max {
+			return number, &ParseError{
+				Line: number.line,
+				Col:  number.col,
+				Msg:  "index out of range",
+			}
+		}
+
+		return number, nil
+	}
+}
+
+// nextLists returns sorted lists of 0-based indice with the given weights
+//
+// @param []int weights The count of indice in each list
+// @param int   max     The largest valid 1-based index
+//
+// @return [][]int, error
+func (s *numberStream) nextLists(weights []int, max int) ([][]int, error) {
+	// initialize the lists
+	lists := make([][]int, len(weights))
+
+	// iterate through the lists
+	for k, weight := range weights {
+		list := make([]int, 0, weight)
+
+		for len(list) < weight {
+			number, err := s.nextIndex(max)
+			if err != nil {
+				return nil, err
+			}
+
+			// each index may only be listed once
+			if containsInt(list, number.value-1) {
+				return nil, &ParseError{
+					Line: number.line,
+					Col:  number.col,
+					Msg:  "duplicate index",
+				}
+			}
+
+			list = append(list, number.value-1)
+		}
+
+		sort.Ints(list)
+		lists[k] = list
+	}
+
+	return lists, nil
+}
+
+// end verifies that only padding zeros are left
+//
+// @return error
+func (s *numberStream) end() error {
+	for _, number := range s.numbers[s.pos:] {
+		if number.value != 0 {
+			return &ParseError{
+				Line: number.line,
+				Col:  number.col,
+				Msg:  "unexpected number after the lists",
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeNumbers writes the numbers separated by spaces in one line
+//
+// The line is padded with zeros to at least width numbers.
+//
+// @param *bufio.Writer writer  The writer to write the line to
+// @param []int         numbers The numbers to write
+// @param int           width   The minimal count of numbers
+func writeNumbers(writer *bufio.Writer, numbers []int, width int) {
+	// iterate through the numbers and the padding
+	for k := 0; k < len(numbers) || k < width; k++ {
+		if k > 0 {
+			writer.WriteByte(' ')
+		}
+
+		if k < len(numbers) {
+			writer.WriteString(strconv.Itoa(numbers[k]))
+		} else {
+			writer.WriteByte('0')
+		}
+	}
+
+	writer.WriteByte('\n')
+}
+
+// oneBased converts 0-based indice into 1-based indice
+//
+// @param []int indices The 0-based indice
+//
+// @return []int
+func oneBased(indices []int) []int {
+	// initialize the result
+	result := make([]int, len(indices))
+
+	// shift each index
+	for k, index := range indices {
+		result[k] = index + 1
+	}
+
+	return result
+}
+
+// containsInt checks if the unsorted list contains the value
+//
+// @param []int list  The list to search
+// @param int   value The value to search
+//
+// @return bool
+func containsInt(list []int, value int) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sumInts returns the sum of the numbers
+//
+// @param []int numbers The numbers to add
+//
+// @return int
+func sumInts(numbers []int) int {
+	sum := 0
+	for _, number := range numbers {
+		sum += number
+	}
+
+	return sum
+}
+
+// maxInt returns the largest number or 0 if there are no numbers
+//
+// @param []int numbers The numbers to compare
+//
+// @return int
+func maxInt(numbers []int) int {
+	max := 0
+	for _, number := range numbers {
+		if number > max {
+			max = number
+		}
+	}
+
+	return max
+}
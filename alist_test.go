@@ -0,0 +1,129 @@
+package gomatrix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlist(t *testing.T) {
+	input := "4 3\n2 3\n2 2 1 1\n2 3 1\n1 3\n1 2\n2 0\n2 0\n1 2 0\n2 3 4\n1 0 0\n"
+	expected := NewSparseF2(3, 4).Set([][]int{{0, 1}, {1, 2, 3}, {0}})
+
+	s, err := ParseAlistSparse(strings.NewReader(input))
+	assert.Nil(t, err)
+	assert.True(t, expected.IsEqual(s))
+
+	// the writer pads the lists like the input
+	var buffer bytes.Buffer
+	assert.Nil(t, s.WriteAlist(&buffer))
+	assert.Equal(t, input, buffer.String())
+
+	// the padding and the line breaks are optional
+	f, err := ParseAlist(strings.NewReader("4 3 2 3 2 2 1 1 2 3 1 1 3 1 2 2 2 1 2 2 3 4 1"))
+	assert.Nil(t, err)
+	assert.True(t, expected.ToF2().IsEqual(f))
+}
+
+func TestAlistRoundTrip(t *testing.T) {
+	matrices := []*F2{
+		randomF2(20, 37, 1),
+		randomSparseF2(50, 100, 3, 2).ToF2(),
+		NewF2(3, 4),
+		NewF2(0, 0),
+	}
+
+	for _, matrix := range matrices {
+		var buffer bytes.Buffer
+		assert.Nil(t, matrix.WriteAlist(&buffer))
+
+		result, err := ParseAlist(&buffer)
+		assert.Nil(t, err)
+		assert.True(t, matrix.IsEqual(result))
+	}
+}
+
+func TestAlistError(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		line        int
+		col         int
+	}{
+		{
+			description: "invalid number",
+			input:       "2 2\n1 x\n",
+			line:        2,
+			col:         3,
+		},
+		{
+			description: "missing weights",
+			input:       "2 2\n1 1\n1 1\n",
+			line:        3,
+			col:         4,
+		},
+		{
+			description: "weight exceeds maximum",
+			input:       "2 2\n1 1\n2 1\n1 1\n",
+			line:        3,
+			col:         1,
+		},
+		{
+			description: "different total weights",
+			input:       "2 2\n1 1\n1 1\n1 0\n",
+			line:        1,
+			col:         1,
+		},
+		{
+			description: "index out of range",
+			input:       "2 2\n1 1\n1 1\n1 1\n3\n1\n1\n2\n",
+			line:        5,
+			col:         1,
+		},
+		{
+			description: "lists do not match",
+			input:       "2 2\n1 1\n1 1\n1 1\n1\n2\n2\n1\n",
+			line:        7,
+			col:         1,
+		},
+		{
+			description: "number after the lists",
+			input:       "2 2\n1 1\n1 1\n1 1\n1\n2\n1\n2\n0 3\n",
+			line:        9,
+			col:         3,
+		},
+		{
+			description: "huge count of columns",
+			input:       "9223372036854775807 1 1 1\n",
+			line:        1,
+			col:         26,
+		},
+		{
+			description: "huge count of rows",
+			input:       "1 4000000000 1 1\n1\n1\n",
+			line:        3,
+			col:         2,
+		},
+		{
+			description: "huge weight",
+			input:       "1 1 999999999999 1\n999999999999\n1\n1\n1\n",
+			line:        2,
+			col:         1,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := ParseAlist(strings.NewReader(test.input))
+		assert.Nilf(t, result, test.description)
+
+		parseErr, ok := err.(*ParseError)
+		if !assert.Truef(t, ok, test.description) {
+			continue
+		}
+
+		assert.Equalf(t, test.line, parseErr.Line, test.description)
+		assert.Equalf(t, test.col, parseErr.Col, test.description)
+	}
+}
@@ -0,0 +1,165 @@
+package gomatrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"math"
+	"math/big"
+)
+
+// binaryMagic identifies the binary encoding of F2
+var binaryMagic = []byte("GF2M")
+
+// binaryVersion is the current version of the binary encoding
+const binaryVersion = 1
+
+// binaryHeaderSize is the size of the magic, version and dimensions
+const binaryHeaderSize = 4 + 1 + 8 + 8
+
+// binaryMaxEmptyRows is the maximal count of rows of an encoded matrix
+// without columns. These rows need no bytes, so their count cannot be
+// verified with the size of the data.
+const binaryMaxEmptyRows = 1 << 16
+
+// MarshalBinary encodes the matrix into a compact binary layout
+//
+// The layout consists of the magic "GF2M", a version byte, N and M as 64
+// bit big endian integers, the bit-packed rows and a CRC-32 (IEEE) of all
+// previous bytes. Each row uses (M+7)/8 bytes, where the column j is stored
+// at bit j%8 of byte j/8. Matrices without columns and more than
+// binaryMaxEmptyRows rows cannot be decoded, so ErrDimensionMismatch is
+// returned for them. This implements encoding.BinaryMarshaler.
+//
+// @return []byte, error
+func (f *F2) MarshalBinary() ([]byte, error) {
+	// the count of rows without columns is limited
+	if f.M == 0 && f.N > binaryMaxEmptyRows {
+		return nil, ErrDimensionMismatch
+	}
+
+	// get the count of bytes per row
+	rowBytes := (f.M + 7) / 8
+
+	// initialize the buffer
+	data := make([]byte, binaryHeaderSize, binaryHeaderSize+f.N*rowBytes+4)
+
+	// write the header
+	copy(data, binaryMagic)
+	data[4] = binaryVersion
+	binary.BigEndian.PutUint64(data[5:], uint64(f.N))
+	binary.BigEndian.PutUint64(data[13:], uint64(f.M))
+
+	// write the rows
+	for _, row := range f.Rows {
+		data = appendRowBytes(data, row, rowBytes)
+	}
+
+	// append the checksum
+	checksum := make([]byte, 4)
+	binary.BigEndian.PutUint32(checksum, crc32.ChecksumIEEE(data))
+
+	return append(data, checksum...), nil
+}
+
+// UnmarshalBinary decodes the matrix from the layout of MarshalBinary
+//
+// The matrix is only modified if the data could be decoded. Matrices without
+// columns are limited to binaryMaxEmptyRows rows, so a forged header cannot
+// allocate arbitrary amounts of memory. This implements
+// encoding.BinaryUnmarshaler.
+//
+// @param []byte data The encoded matrix
+//
+// @return error
+func (f *F2) UnmarshalBinary(data []byte) error {
+	// verify the magic and the minimal size
+	if len(data) < binaryHeaderSize+4 || !bytes.Equal(data[:4], binaryMagic) {
+		return ErrInvalidEncoding
+	}
+
+	// verify the version
+	if data[4] != binaryVersion {
+		return ErrUnsupportedVersion
+	}
+
+	// verify the checksum
+	payload := data[:len(data)-4]
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(data[len(data)-4:]) {
+		return ErrChecksumMismatch
+	}
+
+	// read the dimensions
+	n := binary.BigEndian.Uint64(data[5:])
+	m := binary.BigEndian.Uint64(data[13:])
+
+	// verify that the dimensions can be stored in an int
+	if n > math.MaxInt32 || m > math.MaxInt32 {
+		return ErrInvalidEncoding
+	}
+
+	// the count of rows without columns is not bound by the size of the data
+	if m == 0 && n > binaryMaxEmptyRows {
+		return ErrInvalidEncoding
+	}
+
+	// verify the size of the rows
+	rows := payload[binaryHeaderSize:]
+	rowBytes := (int(m) + 7) / 8
+	if uint64(len(rows)) != n*uint64(rowBytes) {
+		return ErrInvalidEncoding
+	}
+
+	// decode the rows
+	result := NewF2(int(n), int(m))
+	for i, row := range result.Rows {
+		setRowBytes(row, rows[i*rowBytes:(i+1)*rowBytes])
+
+		// the bits behind the last column need to be 0
+		if row.BitLen() > result.M {
+			return ErrInvalidEncoding
+		}
+	}
+
+	// save the matrix
+	f.N, f.M, f.Rows = result.N, result.M, result.Rows
+
+	return nil
+}
+
+// appendRowBytes appends the bits of the row as little endian bytes
+//
+// @param []byte   data     The buffer to append to
+// @param *big.Int row      The row to append
+// @param int      rowBytes The count of bytes to append
+//
+// @return []byte
+func appendRowBytes(data []byte, row *big.Int, rowBytes int) []byte {
+	// get the big endian bytes of the row
+	bigEndian := row.Bytes()
+
+	// append the bytes in reversed order and fill up with 0
+	for k := 0; k < rowBytes; k++ {
+		if k < len(bigEndian) {
+			data = append(data, bigEndian[len(bigEndian)-1-k])
+		} else {
+			data = append(data, 0)
+		}
+	}
+
+	return data
+}
+
+// setRowBytes sets the row to the bits of the little endian bytes
+//
+// @param *big.Int row  The row to set
+// @param []byte   data The bytes of the row
+func setRowBytes(row *big.Int, data []byte) {
+	// reverse the bytes into big endian order
+	bigEndian := make([]byte, len(data))
+	for k, b := range data {
+		bigEndian[len(data)-1-k] = b
+	}
+
+	row.SetBytes(bigEndian)
+}
@@ -0,0 +1,152 @@
+package gomatrix
+
+import (
+	"encoding"
+	"encoding/binary"
+	"hash/crc32"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// the matrix can be used with the encoding interfaces
+var _ encoding.BinaryMarshaler = &F2{}
+var _ encoding.BinaryUnmarshaler = &F2{}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *F2
+	}{
+		{
+			description: "3x3 matrix",
+			matrix:      NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(2)}),
+		},
+		{
+			description: "trailing zero columns and rows",
+			matrix:      NewF2(3, 130).Set([]*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(0)}),
+		},
+		{
+			description: "large random matrix",
+			matrix:      randomF2(17, 203, 1),
+		},
+		{
+			description: "matrix without columns",
+			matrix:      NewF2(4, 0),
+		},
+		{
+			description: "empty matrix",
+			matrix:      NewF2(0, 0),
+		},
+	}
+
+	for _, test := range tests {
+		data, err := test.matrix.MarshalBinary()
+		assert.Nilf(t, err, test.description)
+		assert.Equalf(t, 21+test.matrix.N*((test.matrix.M+7)/8)+4, len(data), test.description)
+
+		result := &F2{}
+		assert.Nilf(t, result.UnmarshalBinary(data), test.description)
+
+		assert.Equalf(t, test.matrix.N, result.N, test.description)
+		assert.Equalf(t, test.matrix.M, result.M, test.description)
+		assert.Truef(t, test.matrix.IsEqual(result), test.description)
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	data, _ := randomF2(3, 10, 1).MarshalBinary()
+
+	// withChecksum replaces the checksum of the modified data
+	withChecksum := func(modify func([]byte) []byte) []byte {
+		modified := modify(append([]byte(nil), data[:len(data)-4]...))
+		checksum := make([]byte, 4)
+		binary.BigEndian.PutUint32(checksum, crc32.ChecksumIEEE(modified))
+
+		return append(modified, checksum...)
+	}
+
+	tests := []struct {
+		description string
+		data        []byte
+		err         error
+	}{
+		{
+			description: "too short",
+			data:        data[:10],
+			err:         ErrInvalidEncoding,
+		},
+		{
+			description: "wrong magic",
+			data: withChecksum(func(d []byte) []byte {
+				d[0] = 'X'
+				return d
+			}),
+			err: ErrInvalidEncoding,
+		},
+		{
+			description: "unknown version",
+			data: withChecksum(func(d []byte) []byte {
+				d[4] = 2
+				return d
+			}),
+			err: ErrUnsupportedVersion,
+		},
+		{
+			description: "corrupted bit",
+			data: func() []byte {
+				corrupted := append([]byte(nil), data...)
+				corrupted[22] ^= 1
+				return corrupted
+			}(),
+			err: ErrChecksumMismatch,
+		},
+		{
+			description: "missing row",
+			data: withChecksum(func(d []byte) []byte {
+				return d[:len(d)-2]
+			}),
+			err: ErrInvalidEncoding,
+		},
+		{
+			description: "bits behind the last column",
+			data: withChecksum(func(d []byte) []byte {
+				d[22] |= 0x80
+				return d
+			}),
+			err: ErrInvalidEncoding,
+		},
+		{
+			description: "huge count of rows without columns",
+			data: withChecksum(func(d []byte) []byte {
+				d = d[:binaryHeaderSize]
+				binary.BigEndian.PutUint64(d[5:], 2000000000)
+				binary.BigEndian.PutUint64(d[13:], 0)
+				return d
+			}),
+			err: ErrInvalidEncoding,
+		},
+	}
+
+	for _, test := range tests {
+		matrix := NewF2(1, 1)
+
+		assert.Equalf(t, test.err, matrix.UnmarshalBinary(test.data), test.description)
+
+		// the matrix is not modified on errors
+		assert.Truef(t, matrix.IsEqual(NewF2(1, 1)), test.description)
+	}
+}
+
+func TestUnmarshalBinaryEmptyRows(t *testing.T) {
+	// the maximal count of rows without columns can be decoded
+	data, _ := NewF2(binaryMaxEmptyRows, 0).MarshalBinary()
+
+	matrix := &F2{}
+	assert.Nil(t, matrix.UnmarshalBinary(data))
+	assert.True(t, NewF2(binaryMaxEmptyRows, 0).IsEqual(matrix))
+
+	_, err := NewF2(binaryMaxEmptyRows+1, 0).MarshalBinary()
+	assert.Equal(t, ErrDimensionMismatch, err)
+}
@@ -0,0 +1,165 @@
+package gomatrix
+
+import (
+	"math/big"
+)
+
+// HConcat concatenates the matrices horizontally to [a|b|...]
+//
+// All matrices need the same count of rows, otherwise ErrDimensionMismatch
+// is returned. The row of each matrix is shifted behind the columns of the
+// previous matrices and combined with the row of the result by OR.
+//
+// @param ...*F2 matrices The matrices to concatenate
+//
+// @return *F2, error
+func HConcat(matrices ...*F2) (*F2, error) {
+	// the concatenation of no matrices is empty
+	if len(matrices) == 0 {
+		return NewF2(0, 0), nil
+	}
+
+	// verify the dimensions and count the columns
+	m := 0
+	for _, matrix := range matrices {
+		if matrix.N != matrices[0].N {
+			return nil, ErrDimensionMismatch
+		}
+
+		m += matrix.M
+	}
+
+	// create the result matrix
+	result := NewF2(matrices[0].N, m)
+
+	// process the rows concurrently
+	parallelRows(result.N, func(start, stop int) {
+		// initialize the buffer for the shifted rows
+		shifted := big.NewInt(0)
+
+		// iterate through the rows
+		for i := start; i < stop; i++ {
+			// move the row of each matrix behind the previous ones
+			offset := 0
+			for _, matrix := range matrices {
+				shifted.Lsh(matrix.Rows[i], uint(offset))
+				result.Rows[i].Or(result.Rows[i], shifted)
+
+				offset += matrix.M
+			}
+		}
+	})
+
+	return result, nil
+}
+
+// VConcat concatenates the matrices vertically to [a;b;...]
+//
+// All matrices need the same count of columns, otherwise
+// ErrDimensionMismatch is returned. The rows are copied into the result.
+//
+// @param ...*F2 matrices The matrices to concatenate
+//
+// @return *F2, error
+func VConcat(matrices ...*F2) (*F2, error) {
+	// the concatenation of no matrices is empty
+	if len(matrices) == 0 {
+		return NewF2(0, 0), nil
+	}
+
+	// verify the dimensions and count the rows
+	n := 0
+	for _, matrix := range matrices {
+		if matrix.M != matrices[0].M {
+			return nil, ErrDimensionMismatch
+		}
+
+		n += matrix.N
+	}
+
+	// create the result matrix
+	result := NewF2(n, matrices[0].M)
+
+	// copy the rows of each matrix below the previous ones
+	offset := 0
+	for _, matrix := range matrices {
+		for i, row := range matrix.Rows {
+			result.Rows[offset+i].Set(row)
+		}
+
+		offset += matrix.N
+	}
+
+	return result, nil
+}
+
+// DirectSum creates the block diagonal matrix diag(a, b, ...)
+//
+// Each matrix is placed below and right of the previous one, all other
+// entries are 0.
+//
+// @param ...*F2 matrices The matrices on the diagonal
+//
+// @return *F2
+func DirectSum(matrices ...*F2) *F2 {
+	// count the rows and columns
+	n, m := 0, 0
+	for _, matrix := range matrices {
+		n += matrix.N
+		m += matrix.M
+	}
+
+	// create the result matrix
+	result := NewF2(n, m)
+
+	// move each matrix to its position on the diagonal
+	rowOffset, colOffset := 0, 0
+	for _, matrix := range matrices {
+		for i, row := range matrix.Rows {
+			result.Rows[rowOffset+i].Lsh(row, uint(colOffset))
+		}
+
+		rowOffset += matrix.N
+		colOffset += matrix.M
+	}
+
+	return result
+}
+
+// Kronecker creates the kronecker product of a and b
+//
+// The result consists of a.N x a.M blocks of the size of b. The block i, j
+// is b, if a contains a 1 at index i, j, and 0 otherwise.
+//
+// @param *F2 a The matrix that selects the blocks
+// @param *F2 b The matrix in the blocks
+//
+// @return *F2
+func Kronecker(a, b *F2) *F2 {
+	// create the result matrix
+	result := NewF2(a.N*b.N, a.M*b.M)
+
+	// process the rows of a concurrently
+	parallelRows(a.N, func(start, stop int) {
+		// initialize the buffer for the shifted rows
+		shifted := big.NewInt(0)
+
+		// iterate through the rows of a
+		for i := start; i < stop; i++ {
+			// iterate through the ones of the row
+			for j := 0; j < a.M; j++ {
+				if a.Rows[i].Bit(j) == 0 {
+					continue
+				}
+
+				// add b to the block i, j
+				for k, row := range b.Rows {
+					shifted.Lsh(row, uint(j*b.M))
+					result.Rows[i*b.N+k].Or(result.Rows[i*b.N+k], shifted)
+				}
+			}
+		}
+	})
+
+	return result
+}
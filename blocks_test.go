@@ -0,0 +1,86 @@
+package gomatrix
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHConcat(t *testing.T) {
+	a := randomF2(5, 70, 1)
+	b := randomF2(5, 3, 2)
+	c := randomF2(5, 90, 3)
+
+	result, err := HConcat(a, b, c)
+	assert.Nil(t, err)
+	assert.Equal(t, 163, result.M)
+
+	assert.True(t, result.GetSubMatrix(0, 0, 5, 70).IsEqual(a))
+	assert.True(t, result.GetSubMatrix(0, 70, 5, 73).IsEqual(b))
+	assert.True(t, result.GetSubMatrix(0, 73, 5, 163).IsEqual(c))
+
+	_, err = HConcat(a, randomF2(4, 3, 4))
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	result, err = HConcat()
+	assert.Nil(t, err)
+	assert.True(t, result.IsEqual(NewF2(0, 0)))
+}
+
+func TestVConcat(t *testing.T) {
+	a := randomF2(5, 70, 1)
+	b := randomF2(2, 70, 2)
+
+	result, err := VConcat(a, b)
+	assert.Nil(t, err)
+	assert.Equal(t, 7, result.N)
+
+	assert.True(t, result.GetSubMatrix(0, 0, 5, 70).IsEqual(a))
+	assert.True(t, result.GetSubMatrix(5, 0, 7, 70).IsEqual(b))
+
+	// the rows are copies
+	result.Rows[0].SetInt64(0)
+	assert.False(t, a.Rows[0].Sign() == 0)
+
+	_, err = VConcat(a, randomF2(2, 69, 3))
+	assert.Equal(t, ErrDimensionMismatch, err)
+}
+
+func TestDirectSum(t *testing.T) {
+	a := NewF2(1, 2).Set([]*big.Int{big.NewInt(3)})
+	b := NewF2(2, 1).Set([]*big.Int{big.NewInt(1), big.NewInt(0)})
+
+	expected := NewF2(3, 3).Set([]*big.Int{
+		big.NewInt(3),
+		big.NewInt(4),
+		big.NewInt(0),
+	})
+
+	assert.True(t, DirectSum(a, b).IsEqual(expected))
+}
+
+func TestKronecker(t *testing.T) {
+	a := NewF2(2, 2).Set([]*big.Int{big.NewInt(1), big.NewInt(3)})
+	b := NewF2(2, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(2)})
+
+	expected := NewF2(4, 6).Set([]*big.Int{
+		big.NewInt(5),
+		big.NewInt(2),
+		big.NewInt(45),
+		big.NewInt(18),
+	})
+
+	assert.True(t, Kronecker(a, b).IsEqual(expected))
+
+	// the kronecker product is compatible with the multiplication
+	c := randomF2(3, 4, 1)
+	d := randomF2(4, 2, 2)
+	e := randomF2(2, 5, 3)
+	g := randomF2(5, 3, 4)
+
+	left := Must(Mul(Kronecker(c, e), Kronecker(d, g)))
+	right := Kronecker(Must(Mul(c, d)), Must(Mul(e, g)))
+
+	assert.True(t, left.IsEqual(right))
+}
@@ -0,0 +1,302 @@
+package gomatrix
+
+import (
+	"math/big"
+)
+
+// AppendRows appends copies of the given rows to the matrix
+//
+// If any row does not fit into the matrix, ErrDimensionMismatch is returned
+// and the matrix is not modified.
+//
+// @param ...*big.Int rows The rows to append
+//
+// @return error
+func (f *F2) AppendRows(rows ...*big.Int) error {
+	// verify all rows before modifying the matrix
+	for _, row := range rows {
+		if row.Sign() < 0 || row.BitLen() > f.M {
+			return ErrDimensionMismatch
+		}
+	}
+
+	// append the copies of the rows
+	for _, row := range rows {
+		f.Rows = append(f.Rows, big.NewInt(0).Set(row))
+	}
+
+	// save the new count of rows
+	f.N = len(f.Rows)
+
+	return nil
+}
+
+// InsertCols inserts the columns of cols before the column at index j
+//
+// The columns from j on are moved to the right by cols.M columns. j = f.M
+// appends the columns. If cols does not have the same count of rows,
+// ErrDimensionMismatch is returned.
+//
+// @param int j    The index of the first inserted column
+// @param *F2 cols The columns to insert
+//
+// @return error
+func (f *F2) InsertCols(j int, cols *F2) error {
+	// check if the index is in the matrix
+	if j < 0 || j > f.M {
+		return ErrIndexOutOfRange
+	}
+
+	// the columns need a value for each row
+	if cols.N != f.N {
+		return ErrDimensionMismatch
+	}
+
+	// create the mask for the columns in front of j
+	mask := lowBitMask(j)
+
+	// process the rows concurrently
+	parallelRows(f.N, func(start, stop int) {
+		// initialize the buffers for the parts of the row
+		high := big.NewInt(0)
+		inserted := big.NewInt(0)
+
+		// iterate through the rows
+		for i := start; i < stop; i++ {
+			row := f.Rows[i]
+
+			// move the columns from j on behind the inserted columns
+			high.Rsh(row, uint(j)).Lsh(high, uint(j+cols.M))
+
+			// move the inserted columns to j
+			inserted.Lsh(cols.Rows[i], uint(j))
+
+			// combine the parts
+			row.And(row, mask).Or(row, inserted).Or(row, high)
+		}
+	})
+
+	// save the new count of columns
+	f.M += cols.M
+
+	return nil
+}
+
+// DeleteRows removes the rows at the given indice
+//
+// Indice can be given multiple times. If any index is not in the matrix,
+// ErrIndexOutOfRange is returned and the matrix is not modified.
+//
+// @param ...int indices The indice of the rows to remove
+//
+// @return error
+func (f *F2) DeleteRows(indices ...int) error {
+	// mark the rows to remove
+	deleted, err := markIndices(indices, f.N)
+	if err != nil {
+		return err
+	}
+
+	// initialize the remaining rows
+	rows := make([]*big.Int, 0, f.N)
+
+	// keep the rows that are not marked
+	for i, row := range f.Rows {
+		if !deleted[i] {
+			rows = append(rows, row)
+		}
+	}
+
+	// save the remaining rows
+	f.Rows = rows
+	f.N = len(rows)
+
+	return nil
+}
+
+// DeleteCols removes the columns at the given indice
+//
+// Indice can be given multiple times. If any index is not in the matrix,
+// ErrIndexOutOfRange is returned and the matrix is not modified.
+//
+// @param ...int indices The indice of the columns to remove
+//
+// @return error
+func (f *F2) DeleteCols(indices ...int) error {
+	// mark the columns to remove
+	deleted, err := markIndices(indices, f.M)
+	if err != nil {
+		return err
+	}
+
+	// collect the remaining columns in increasing order
+	cols := make([]int, 0, f.M)
+	for j := 0; j < f.M; j++ {
+		if !deleted[j] {
+			cols = append(cols, j)
+		}
+	}
+
+	// select the remaining columns
+	result := f.selectCols(cols)
+
+	// save the result matrix
+	f.M = result.M
+	f.Rows = result.Rows
+
+	return nil
+}
+
+// SelectRows returns a new matrix with copies of the given rows
+//
+// The row i of the result is the row indices[i] of f, so rows can be
+// reordered and repeated. f is not modified.
+//
+// @param []int indices The indice of the rows to select
+//
+// @return *F2, error
+func (f *F2) SelectRows(indices []int) (*F2, error) {
+	// create the result matrix
+	result := NewF2(len(indices), f.M)
+
+	// copy the selected rows
+	for i, k := range indices {
+		// check if the index is in the matrix
+		if k < 0 || k >= f.N {
+			return nil, ErrIndexOutOfRange
+		}
+
+		result.Rows[i].Set(f.Rows[k])
+	}
+
+	return result, nil
+}
+
+// SelectCols returns a new matrix with copies of the given columns
+//
+// The column j of the result is the column indices[j] of f, so columns can
+// be reordered and repeated. Consecutive increasing indice are copied as a
+// single block of bits, which makes selecting ranges of columns fast
+// despite the row-major layout. f is not modified.
+//
+// @param []int indices The indice of the columns to select
+//
+// @return *F2, error
+func (f *F2) SelectCols(indices []int) (*F2, error) {
+	// check if the indice are in the matrix
+	for _, j := range indices {
+		if j < 0 || j >= f.M {
+			return nil, ErrIndexOutOfRange
+		}
+	}
+
+	return f.selectCols(indices), nil
+}
+
+// selectCols returns a new matrix with copies of the given columns without
+// verifying the indice
+//
+// @param []int indices The indice of the columns to select
+//
+// @return *F2
+func (f *F2) selectCols(indices []int) *F2 {
+	// create the result matrix
+	result := NewF2(f.N, len(indices))
+
+	// split the indice into runs of consecutive columns
+	runs := columnRuns(indices)
+
+	// process the rows concurrently
+	parallelRows(f.N, func(start, stop int) {
+		// initialize the buffer for the copied bits
+		bits := big.NewInt(0)
+
+		// iterate through the rows
+		for i := start; i < stop; i++ {
+			// copy each run to its position in the result
+			for _, run := range runs {
+				bits.Rsh(f.Rows[i], uint(run.source))
+				bits.And(bits, run.mask)
+				bits.Lsh(bits, uint(run.target))
+
+				result.Rows[i].Or(result.Rows[i], bits)
+			}
+		}
+	})
+
+	return result
+}
+
+// columnRun describes consecutive columns that are copied as one block
+type columnRun struct {
+	source int
+	target int
+	mask   *big.Int
+}
+
+// columnRuns splits the indice into runs of consecutive increasing columns
+//
+// @param []int indices The indice of the columns
+//
+// @return []columnRun
+func columnRuns(indices []int) []columnRun {
+	// initialize the runs
+	runs := []columnRun{}
+
+	// iterate through the indice
+	for k := 0; k < len(indices); {
+		// extend the run as long as the columns are consecutive
+		length := 1
+		for k+length < len(indices) && indices[k+length] == indices[k]+length {
+			length++
+		}
+
+		// save the run
+		runs = append(runs, columnRun{
+			source: indices[k],
+			target: k,
+			mask:   lowBitMask(length),
+		})
+
+		// continue behind the run
+		k += length
+	}
+
+	return runs
+}
+
+// markIndices marks the given indice in a list of n flags
+//
+// @param []int indices The indice to mark
+// @param int   n       The count of flags
+//
+// @return []bool, error
+func markIndices(indices []int, n int) ([]bool, error) {
+	// initialize the flags
+	marked := make([]bool, n)
+
+	// mark each index
+	for _, k := range indices {
+		// check if the index is in range
+		if k < 0 || k >= n {
+			return nil, ErrIndexOutOfRange
+		}
+
+		marked[k] = true
+	}
+
+	return marked, nil
+}
+
+// lowBitMask returns a number with the lowest n bits set
+//
+// @param int n The count of bits
+//
+// @return *big.Int
+func lowBitMask(n int) *big.Int {
+	// calculate 2^n-1
+	mask := big.NewInt(1)
+	mask.Lsh(mask, uint(n)).Sub(mask, big.NewInt(1))
+
+	return mask
+}
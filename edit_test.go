@@ -0,0 +1,178 @@
+package gomatrix
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendRows(t *testing.T) {
+	matrix := NewF2(1, 3).Set([]*big.Int{big.NewInt(5)})
+	row := big.NewInt(3)
+
+	assert.Nil(t, matrix.AppendRows(row, big.NewInt(4)))
+
+	// the rows are copied into the matrix
+	row.SetInt64(0)
+
+	expected := NewF2(3, 3).Set([]*big.Int{
+		big.NewInt(5),
+		big.NewInt(3),
+		big.NewInt(4),
+	})
+
+	assert.True(t, matrix.IsEqual(expected))
+
+	assert.Equal(t, ErrDimensionMismatch, matrix.AppendRows(big.NewInt(1), big.NewInt(8)))
+	assert.True(t, matrix.IsEqual(expected))
+}
+
+func TestInsertCols(t *testing.T) {
+	tests := []struct {
+		description string
+		j           int
+		expected    *F2
+		err         error
+	}{
+		{
+			description: "insert in front",
+			j:           0,
+			expected:    NewF2(2, 5).Set([]*big.Int{big.NewInt(21), big.NewInt(14)}),
+		},
+		{
+			description: "insert in the middle",
+			j:           1,
+			expected:    NewF2(2, 5).Set([]*big.Int{big.NewInt(19), big.NewInt(13)}),
+		},
+		{
+			description: "append",
+			j:           3,
+			expected:    NewF2(2, 5).Set([]*big.Int{big.NewInt(13), big.NewInt(19)}),
+		},
+		{
+			description: "index out of range",
+			j:           4,
+			err:         ErrIndexOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		matrix := NewF2(2, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3)})
+		cols := NewF2(2, 2).Set([]*big.Int{big.NewInt(1), big.NewInt(2)})
+
+		err := matrix.InsertCols(test.j, cols)
+
+		assert.Equalf(t, test.err, err, test.description)
+
+		if test.err != nil {
+			continue
+		}
+
+		assert.Truef(t, matrix.IsEqual(test.expected), test.description)
+	}
+
+	matrix := NewF2(2, 3)
+	assert.Equal(t, ErrDimensionMismatch, matrix.InsertCols(0, NewF2(3, 1)))
+}
+
+func TestDeleteRows(t *testing.T) {
+	matrix := NewF2(4, 3).Set([]*big.Int{
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(3),
+		big.NewInt(4),
+	})
+
+	assert.Nil(t, matrix.DeleteRows(2, 0, 2))
+
+	expected := NewF2(2, 3).Set([]*big.Int{big.NewInt(2), big.NewInt(4)})
+	assert.True(t, matrix.IsEqual(expected))
+
+	assert.Equal(t, ErrIndexOutOfRange, matrix.DeleteRows(0, 2))
+	assert.True(t, matrix.IsEqual(expected))
+}
+
+func TestDeleteCols(t *testing.T) {
+	matrix := randomF2(5, 150, 1)
+	expected, _ := matrix.SelectCols(append(rangeOf(0, 3), rangeOf(4, 140)...))
+
+	assert.Nil(t, matrix.DeleteCols(3, 140, 149, 145, 141, 142, 143, 144, 146, 147, 148))
+	assert.True(t, matrix.IsEqual(expected))
+
+	assert.Equal(t, ErrIndexOutOfRange, matrix.DeleteCols(139, 139))
+	assert.Equal(t, ErrIndexOutOfRange, matrix.DeleteCols(-1))
+	assert.True(t, matrix.IsEqual(expected))
+}
+
+func TestSelectRows(t *testing.T) {
+	matrix := NewF2(3, 3).Set([]*big.Int{
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(3),
+	})
+
+	result, err := matrix.SelectRows([]int{2, 0, 2})
+	assert.Nil(t, err)
+
+	expected := NewF2(3, 3).Set([]*big.Int{
+		big.NewInt(3),
+		big.NewInt(1),
+		big.NewInt(3),
+	})
+
+	assert.True(t, result.IsEqual(expected))
+
+	// the rows are copies
+	result.Rows[0].SetInt64(0)
+	assert.Equal(t, int64(3), matrix.Rows[2].Int64())
+
+	_, err = matrix.SelectRows([]int{3})
+	assert.Equal(t, ErrIndexOutOfRange, err)
+}
+
+func TestSelectCols(t *testing.T) {
+	matrix := randomF2(7, 200, 2)
+
+	tests := []struct {
+		description string
+		indices     []int
+	}{
+		{
+			description: "range of columns",
+			indices:     rangeOf(60, 190),
+		},
+		{
+			description: "reordered and repeated columns",
+			indices:     []int{199, 0, 1, 2, 70, 70, 5},
+		},
+		{
+			description: "no columns",
+			indices:     []int{},
+		},
+	}
+
+	for _, test := range tests {
+		result, err := matrix.SelectCols(test.indices)
+		assert.Nilf(t, err, test.description)
+		assert.Equalf(t, len(test.indices), result.M, test.description)
+
+		for j, k := range test.indices {
+			assert.Zerof(t, matrix.GetCol(k).Cmp(result.GetCol(j)), test.description)
+		}
+	}
+
+	_, err := matrix.SelectCols([]int{200})
+	assert.Equal(t, ErrIndexOutOfRange, err)
+}
+
+// rangeOf returns the indice from start to stop-1
+func rangeOf(start, stop int) []int {
+	indices := make([]int, 0, stop-start)
+
+	for i := start; i < stop; i++ {
+		indices = append(indices, i)
+	}
+
+	return indices
+}
@@ -0,0 +1,162 @@
+package gomatrix
+
+import (
+	"math/big"
+)
+
+// Encoder multiplies many row vectors with the same generator matrix
+//
+// The rows of the generator matrix are split into blocks of m4rmBits rows,
+// and all sums of the rows of each block are precomputed once. Encoding a
+// message then only needs one addition per block instead of one addition per
+// set bit. The tables need 2^m4rmBits rows per block, so the encoder pays
+// off if a lot of messages are encoded with the same matrix.
+type Encoder struct {
+	n      int
+	m      int
+	tables [][]*big.Int
+}
+
+// NewEncoder creates an encoder for the generator matrix g
+//
+// The generator matrix is not modified and not referenced by the encoder, so
+// later changes of g do not affect the encoder.
+//
+// @param *F2 g The generator matrix
+//
+// @return *Encoder
+func NewEncoder(g *F2) *Encoder {
+	// initialize the tables for the blocks
+	tables := make([][]*big.Int, (g.N+m4rmBits-1)/m4rmBits)
+
+	// precompute the sums of the blocks concurrently
+	parallelRows(len(tables), func(start, stop int) {
+		// iterate through the blocks
+		for b := start; b < stop; b++ {
+			// get the rows of the block
+			k := b * m4rmBits
+			size := m4rmBits
+			if k+size > g.N {
+				size = g.N - k
+			}
+
+			// precompute all sums of the rows in the block
+			tables[b] = newGrayTable(size)
+			fillGrayTable(tables[b], g.Rows[k:k+size])
+		}
+	})
+
+	// return the encoder
+	return &Encoder{
+		n:      g.N,
+		m:      g.M,
+		tables: tables,
+	}
+}
+
+// Encode multiplies the row vector message with the generator matrix
+//
+// @param *Vec message The message with an entry for each row of the matrix
+//
+// @return *Vec, error
+func (e *Encoder) Encode(message *Vec) (*Vec, error) {
+	// the message needs an entry for each row
+	if message.N != e.n {
+		return nil, ErrDimensionMismatch
+	}
+
+	return e.encode(message), nil
+}
+
+// EncodeBatch multiplies each row vector with the generator matrix
+//
+// The messages are encoded concurrently. If any message has the wrong
+// length, ErrDimensionMismatch is returned and no message is encoded.
+//
+// @param []*Vec messages The messages to encode
+//
+// @return []*Vec, error
+func (e *Encoder) EncodeBatch(messages []*Vec) ([]*Vec, error) {
+	// verify all messages before encoding them
+	for _, message := range messages {
+		if message.N != e.n {
+			return nil, ErrDimensionMismatch
+		}
+	}
+
+	// initialize the codewords
+	codewords := make([]*Vec, len(messages))
+
+	// encode the messages concurrently, the tables are only read
+	parallelRows(len(messages), func(start, stop int) {
+		for i := start; i < stop; i++ {
+			codewords[i] = e.encode(messages[i])
+		}
+	})
+
+	return codewords, nil
+}
+
+// EncodeMatrix multiplies the matrix with the generator matrix
+//
+// Each row of messages is one message, so the result is messages*g. The
+// rows are encoded concurrently and messages is not modified.
+//
+// @param *F2 messages The messages to encode
+//
+// @return *F2, error
+func (e *Encoder) EncodeMatrix(messages *F2) (*F2, error) {
+	// the messages need an entry for each row
+	if messages.M != e.n {
+		return nil, ErrDimensionMismatch
+	}
+
+	// create the result matrix
+	result := NewF2(messages.N, e.m)
+
+	// encode the rows concurrently
+	parallelRows(messages.N, func(start, stop int) {
+		for i := start; i < stop; i++ {
+			e.encodeInto(result.Rows[i], messages.Rows[i])
+		}
+	})
+
+	return result, nil
+}
+
+// encode multiplies the message with the generator matrix
+//
+// @param *Vec message The message to encode
+//
+// @return *Vec
+func (e *Encoder) encode(message *Vec) *Vec {
+	// create the codeword
+	codeword := NewVec(e.m)
+
+	// add the sums of the blocks
+	e.encodeInto(codeword.Bits, message.Bits)
+
+	return codeword
+}
+
+// encodeInto adds the product of the message and the generator matrix to
+// the result
+//
+// @param *big.Int result  The number to add the codeword to
+// @param *big.Int message The message to encode
+func (e *Encoder) encodeInto(result, message *big.Int) {
+	// iterate through the blocks
+	for b, table := range e.tables {
+		// get the bits of the message that select the rows of the block
+		index := bitsAt(message, b*m4rmBits, m4rmBits)
+
+		// if no row is selected...
+		if index == 0 {
+			// ...skip the block
+			continue
+		}
+
+		// add the sum of the selected rows
+		result.Xor(result, table[index])
+	}
+}
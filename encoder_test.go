@@ -0,0 +1,104 @@
+package gomatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoder(t *testing.T) {
+	tests := []struct {
+		description string
+		generator   *F2
+	}{
+		{
+			description: "small generator matrix",
+			generator:   randomF2(5, 9, 1),
+		},
+		{
+			description: "rows not divisible by the block size",
+			generator:   randomF2(131, 260, 2),
+		},
+		{
+			description: "empty generator matrix",
+			generator:   NewF2(0, 4),
+		},
+	}
+
+	for _, test := range tests {
+		encoder := NewEncoder(test.generator)
+		messages := randomF2(20, test.generator.N, 3)
+
+		// encode each message on its own and as a batch
+		vectors := make([]*Vec, messages.N)
+		for i := range vectors {
+			vectors[i], _ = messages.RowVec(i)
+		}
+
+		codewords, err := encoder.EncodeBatch(vectors)
+		assert.Nilf(t, err, test.description)
+
+		encoded, err := encoder.EncodeMatrix(messages)
+		assert.Nilf(t, err, test.description)
+
+		for i, vector := range vectors {
+			expected, err := test.generator.VecMul(vector)
+			assert.Nilf(t, err, test.description)
+
+			codeword, err := encoder.Encode(vector)
+			assert.Nilf(t, err, test.description)
+
+			assert.Truef(t, expected.IsEqual(codeword), test.description)
+			assert.Truef(t, expected.IsEqual(codewords[i]), test.description)
+			assert.Zerof(t, expected.Bits.Cmp(encoded.Rows[i]), test.description)
+		}
+	}
+}
+
+func TestEncoderDimensionMismatch(t *testing.T) {
+	encoder := NewEncoder(randomF2(10, 20, 4))
+
+	_, err := encoder.Encode(NewVec(11))
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	_, err = encoder.EncodeBatch([]*Vec{NewVec(10), NewVec(9)})
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	_, err = encoder.EncodeMatrix(NewF2(3, 20))
+	assert.Equal(t, ErrDimensionMismatch, err)
+}
+
+func BenchmarkEncodeMulMatrix(b *testing.B) {
+	generator := randomF2(512, 1024, 1)
+	message := randomF2(1, 512, 2)
+
+	for i := 0; i < b.N; i++ {
+		NewF2(1, 512).Set(message.Rows).MulMatrix(generator)
+	}
+}
+
+func BenchmarkEncodeVecMul(b *testing.B) {
+	generator := randomF2(512, 1024, 1)
+	message, _ := randomF2(1, 512, 2).RowVec(0)
+
+	for i := 0; i < b.N; i++ {
+		generator.VecMul(message)
+	}
+}
+
+func BenchmarkEncodeBatch(b *testing.B) {
+	generator := randomF2(512, 1024, 1)
+	messages := randomF2(256, 512, 2)
+
+	vectors := make([]*Vec, messages.N)
+	for i := range vectors {
+		vectors[i], _ = messages.RowVec(i)
+	}
+
+	encoder := NewEncoder(generator)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		encoder.EncodeBatch(vectors)
+	}
+}
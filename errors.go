@@ -0,0 +1,64 @@
+package gomatrix
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotSquare is returned if an operation requires a square matrix
+	ErrNotSquare = errors.New("matrix is not square")
+
+	// ErrSingular is returned if a matrix cannot be inverted
+	ErrSingular = errors.New("matrix is singular")
+
+	// ErrIndexOutOfRange is returned if an index is not inside of the matrix
+	ErrIndexOutOfRange = errors.New("index out of range")
+
+	// ErrNotPermutation is returned if a matrix is not a permutation matrix
+	ErrNotPermutation = errors.New("matrix is not a permutation matrix")
+
+	// ErrDimensionMismatch is returned if the dimensions of the matrices do
+	// not fit for the operation
+	ErrDimensionMismatch = errors.New("dimension mismatch")
+
+	// ErrInconsistent is returned if a linear system has no solution
+	ErrInconsistent = errors.New("linear system is inconsistent")
+
+	// ErrTooManySolutions is returned if the solutions of a linear system
+	// cannot be enumerated
+	ErrTooManySolutions = errors.New("too many solutions to enumerate")
+
+	// ErrInvalidDimensions is returned if a requested size is negative
+	ErrInvalidDimensions = errors.New("invalid dimensions")
+
+	// ErrInvalidRank is returned if a matrix cannot have the requested rank
+	ErrInvalidRank = errors.New("invalid rank")
+
+	// ErrInvalidEncoding is returned if serialized data cannot be decoded
+	ErrInvalidEncoding = errors.New("invalid encoding")
+
+	// ErrUnsupportedVersion is returned if serialized data has an unknown
+	// format version
+	ErrUnsupportedVersion = errors.New("unsupported encoding version")
+
+	// ErrChecksumMismatch is returned if the checksum of serialized data is
+	// wrong
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+)
+
+// ParseError is returned if a matrix in a text format is malformed
+//
+// Line and Col are the position of the malformed input, both starting at 1.
+type ParseError struct {
+	Line int
+	Col  int
+	Msg  string
+}
+
+// Error returns the message with the position of the malformed input
+//
+// @return string
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Col, e.Msg)
+}
@@ -4,135 +4,348 @@ import (
 	"math/big"
 )
 
-// GaussianElimination converts the matrix to an echelon form
+// RankProfile describes the reduced row echelon form of a matrix
+type RankProfile struct {
+	// Rank is the count of linearly independent rows
+	Rank int
+
+	// PivotCols contains the columns with a pivot bit in increasing order. It
+	// is the column rank profile, i.e. the first linearly independent columns.
+	PivotCols []int
+
+	// FreeCols contains the columns without a pivot bit in increasing order
+	FreeCols []int
+
+	// RowProfile contains the first linearly independent rows in increasing
+	// order, i.e. the row rank profile
+	RowProfile []int
+}
+
+// GaussianElimination converts the matrix to the reduced row echelon form
 //
 // This function applies the gaussian elimination to the matrix in order to
-// create an echelon form.
+// create the reduced row echelon form. For a square matrix with full rank,
+// this is the identity matrix. Rank deficient as well as wide or tall matrices
+// result in the pivot rows at the top and the zero rows at the bottom.
 func (f *F2) GaussianElimination() {
-	// iterate through all possible pivot bits
-	for pivotBit := 0; pivotBit < f.M; pivotBit++ {
-		// iterate through the rows
-		for rowCounter := pivotBit; rowCounter < f.N; rowCounter++ {
-			// if the pivotbit of this row is 0...
-			if f.Rows[rowCounter].Bit(pivotBit) == uint(0) {
-				// ...check the next row
-				continue
-			}
+	f.reduce(0, 0, f.N, f.M)
+}
 
-			// if the row with a valid pivot bit is not the first row...
-			if pivotBit != rowCounter {
-				// ...swap it with first one
-				f.SwapRows(pivotBit, rowCounter)
+// ReducedRowEchelonForm converts the matrix to the reduced row echelon form
+//
+// This function works like GaussianElimination, but it additionally reports
+// the rank, the pivot and free columns and the row rank profile of the
+// matrix before the elimination.
+//
+// @return *RankProfile
+func (f *F2) ReducedRowEchelonForm() *RankProfile {
+	// get the row rank profile before the rows are modified
+	rowProfile := f.rowRankProfile()
+
+	// transform the matrix and save the pivot columns
+	pivotCols := f.reduce(0, 0, f.N, f.M)
+
+	// return the profile
+	return &RankProfile{
+		Rank:       len(pivotCols),
+		PivotCols:  pivotCols,
+		FreeCols:   freeCols(pivotCols, f.M),
+		RowProfile: rowProfile,
+	}
+}
+
+// Rank returns the rank of the matrix without modifying it
+//
+// @return int
+func (f *F2) Rank() int {
+	// reduce a copy of the matrix and count the pivot columns
+	return len(f.Clone().reduce(0, 0, f.N, f.M))
+}
+
+// Kernel returns a basis of the right kernel of the matrix
+//
+// The right kernel contains all column vectors x with f*x = 0. Each row of the
+// returned matrix is one basis vector, so the result has f.M columns and
+// f.M-Rank() rows. The product of f and the transposed result is 0. f is not
+// modified.
+//
+// @return *F2
+func (f *F2) Kernel() *F2 {
+	// reduce a copy of the matrix
+	reduced := f.Clone()
+	pivotCols := reduced.reduce(0, 0, f.N, f.M)
+
+	// create the basis from the reduced matrix
+	return kernelFromReduced(reduced, pivotCols, f.M)
+}
+
+// LeftKernel returns a basis of the left kernel of the matrix
+//
+// The left kernel contains all row vectors y with y*f = 0. Each row of the
+// returned matrix is one basis vector, so the product of the result and f is
+// 0. f is not modified.
+//
+// @return *F2
+func (f *F2) LeftKernel() *F2 {
+	// the left kernel is the right kernel of the transposed matrix
+	return Transpose(f).Kernel()
+}
+
+// reduce converts a part of the matrix to the reduced row echelon form
+//
+// This function searches the pivot bits in the columns startCol to stopCol-1
+// of the rows startRow to stopRow-1. Each pivot bit is eliminated from all
+// other rows of the range, which makes it the only 1 in its column. The row
+// operations are applied to the complete rows. The pivot rows are moved to
+// the top of the range, the remaining rows are 0 in the searched columns.
+//
+// @param int startRow The first row to process
+// @param int startCol The first column to search for pivot bits
+// @param int stopRow  The row after the last row to process
+// @param int stopCol  The column after the last column to search
+//
+// @return []int The columns that contain a pivot bit
+func (f *F2) reduce(startRow, startCol, stopRow, stopCol int) []int {
+	// initialize the pivot columns
+	pivotCols := []int{}
+
+	// initialize the row for the next pivot bit
+	pivotRow := startRow
+
+	// iterate through the columns until all rows contain a pivot bit
+	for col := startCol; col < stopCol && pivotRow < stopRow; col++ {
+		// initialize the index of the row with the pivot bit
+		found := -1
+
+		// search a row with a 1 in the current column
+		for rowCounter := pivotRow; rowCounter < stopRow; rowCounter++ {
+			if f.Rows[rowCounter].Bit(col) != uint(0) {
+				found = rowCounter
+				break
 			}
+		}
 
-			// iterate through all other rows except the first one
-			for rr := pivotBit + 1; rr < f.N; rr++ {
-				if f.Rows[rr].Bit(pivotBit) == uint(0) {
+		// if there is no pivot bit in the column...
+		if found < 0 {
+			// ...it is a free column
+			continue
+		}
+
+		// swap the row with the pivot bit into place
+		f.Rows[pivotRow], f.Rows[found] = f.Rows[found], f.Rows[pivotRow]
+
+		// eliminate the pivot bit from all other rows concurrently
+		parallelRows(stopRow-startRow, func(start, stop int) {
+			for rowCounter := startRow + start; rowCounter < startRow+stop; rowCounter++ {
+				// skip the pivot row and rows with a 0 in the current column
+				if rowCounter == pivotRow || f.Rows[rowCounter].Bit(col) == uint(0) {
 					continue
 				}
 
-				// subtract the 1 from all other rows with the pivotBit
-				f.Rows[rr].Xor(f.Rows[rr], f.Rows[pivotBit])
+				// subtract the pivot row
+				f.Rows[rowCounter].Xor(f.Rows[rowCounter], f.Rows[pivotRow])
 			}
+		})
+
+		// save the pivot column and continue with the next row
+		pivotCols = append(pivotCols, col)
+		pivotRow++
+	}
+
+	return pivotCols
+}
+
+// kernelFromReduced creates the basis of the right kernel from a matrix in the
+// reduced row echelon form
+//
+// @param *F2   reduced   The reduced matrix
+// @param []int pivotCols The pivot columns of the reduced matrix
+// @param int   m         The count of columns that belong to the kernel
+//
+// @return *F2
+func kernelFromReduced(reduced *F2, pivotCols []int, m int) *F2 {
+	// get the columns without a pivot bit
+	free := freeCols(pivotCols, m)
+
+	// create the basis with one vector for each free column
+	kernel := NewF2(len(free), m)
+
+	// iterate through the free columns
+	for k, col := range free {
+		// set the free variable to 1
+		kernel.Rows[k].SetBit(kernel.Rows[k], col, 1)
+
+		// set each pivot variable to the value that eliminates the free column
+		for i, pivotCol := range pivotCols {
+			kernel.Rows[k].SetBit(kernel.Rows[k], pivotCol, reduced.Rows[i].Bit(col))
 		}
 	}
 
-	// do the same thing backwards to get the identity matrix
-	f.diagonalize()
+	return kernel
 }
 
-// diagonalize Diagonalizes the matrix after creating the triangular matrix
+// rowRankProfile returns the first linearly independent rows of the matrix
 //
-// This function removes the top right 1 entries in a matrix with the
-// echelon form.
-func (f *F2) diagonalize() {
-	// iterate backwards through the pivot bits
-	for pivotBit := f.M - 1; pivotBit >= 0; pivotBit-- {
-		// choose each row from the top row to the one with the pivot bit
-		for rowCounter := 0; rowCounter < pivotBit; rowCounter++ {
-			// if the bit in the same position at the other row is 0...
-			if f.Rows[rowCounter].Bit(pivotBit) == uint(0) {
-				// ...continue to the next row
-				continue
+// Each row is reduced with a basis of the previous rows. The basis is indexed
+// by the lowest set bit of each basis vector, so that each reduction step
+// clears the lowest bit of the row. If the row is not 0 after the reduction,
+// it is independent of all previous rows.
+//
+// @return []int
+func (f *F2) rowRankProfile() []int {
+	// initialize the profile and the basis
+	profile := []int{}
+	basis := map[uint]*big.Int{}
+
+	// iterate through the rows
+	for i, row := range f.Rows {
+		// reduce a copy of the row
+		reduced := new(big.Int).Set(row)
+
+		for reduced.Sign() != 0 {
+			// get the lowest set bit
+			lowestBit := reduced.TrailingZeroBits()
+
+			// if there is no basis vector for this bit...
+			if _, ok := basis[lowestBit]; !ok {
+				// ...the row is independent
+				basis[lowestBit] = reduced
+				profile = append(profile, i)
+				break
 			}
 
-			// eliminate the 1
-			f.Rows[rowCounter].Xor(f.Rows[rowCounter], f.Rows[pivotBit])
+			// clear the lowest bit
+			reduced.Xor(reduced, basis[lowestBit])
 		}
 	}
+
+	return profile
 }
 
-// PartialGaussianElimination performs a gaussian elimination on a part of the matrix
-func (f *F2) PartialGaussianElimination(startRow, startCol, stopRow, stopCol int) {
-	// iterate through all possible pivot bits
-	for pivotBit := startCol; pivotBit <= stopCol; pivotBit++ {
-		// iterate through the rows
-		for rowCounter := startRow + pivotBit - startCol; rowCounter <= stopRow; rowCounter++ {
-			// if the pivotbit of this row is 0...
-			if f.Rows[rowCounter].Bit(pivotBit) == uint(0) {
-				// ...check the next row
-				continue
-			}
+// freeCols returns all columns below m that are not pivot columns
+//
+// @param []int pivotCols The pivot columns in increasing order
+// @param int   m         The count of columns
+//
+// @return []int
+func freeCols(pivotCols []int, m int) []int {
+	// initialize the free columns
+	free := []int{}
+
+	// iterate through the columns while skipping the pivot columns
+	for col, next := 0, 0; col < m; col++ {
+		if next < len(pivotCols) && pivotCols[next] == col {
+			next++
+			continue
+		}
 
-			// if the row with a valid pivot bit is not the first row...
-			if startRow+pivotBit-startCol != rowCounter {
-				// ...swap it with first one
-				f.SwapRows(startRow+pivotBit-startCol, rowCounter)
-			}
+		free = append(free, col)
+	}
 
-			// iterate through all other rows except the first one
-			for rr := startRow + pivotBit - startCol + 1; rr <= stopRow; rr++ {
-				if f.Rows[rr].Bit(pivotBit) == uint(0) {
-					continue
-				}
+	return free
+}
 
-				// subtract the 1 from all other rows with the pivotBit
-				f.Rows[rr].Xor(
-					f.Rows[rr],
-					f.Rows[startRow+pivotBit-startCol],
-				)
-			}
+// PartialGaussianElimination performs a gaussian elimination on a part of the matrix
+//
+// The stop boundaries are included. The pivot bit of the column
+// startCol+k is moved to the row startRow+k, so this row needs to be inside
+// of the matrix for each column. Otherwise ErrIndexOutOfRange is returned
+// and the matrix is not modified.
+//
+// @param int startRow The first row to process
+// @param int startCol The first column to process
+// @param int stopRow  The last row to process
+// @param int stopCol  The last column to process
+//
+// @return error
+func (f *F2) PartialGaussianElimination(startRow, startCol, stopRow, stopCol int) error {
+	// verify the range
+	if err := checkGaussRange(f.N, f.M, startRow, startCol, stopRow, stopCol); err != nil {
+		return err
+	}
 
-			break
-		}
+	// an empty range does not change the matrix
+	if stopRow < startRow || stopCol < startCol {
+		return nil
 	}
 
+	// move the pivot bits of the range to the diagonal
+	f.view(startRow, startCol, stopRow+1, stopCol+1).forwardEliminate()
+
 	// do the same thing backwards to get the identity matrix
 	f.partialDiagonalize(startRow, startCol, stopRow, stopCol, nil)
+
+	return nil
+}
+
+// checkGaussRange verifies the range of a partial gaussian elimination
+//
+// Empty ranges are valid. Otherwise the range needs to be inside of the
+// matrix, including the pivot row of the last column.
+//
+// @param int n        The count of rows of the matrix
+// @param int m        The count of columns of the matrix
+// @param int startRow The first row to process
+// @param int startCol The first column to process
+// @param int stopRow  The last row to process
+// @param int stopCol  The last column to process
+//
+// @return error
+func checkGaussRange(n, m, startRow, startCol, stopRow, stopCol int) error {
+	// the start needs to be inside of the matrix
+	if startRow < 0 || startCol < 0 {
+		return ErrIndexOutOfRange
+	}
+
+	// an empty range does not access the matrix
+	if stopRow < startRow || stopCol < startCol {
+		return nil
+	}
+
+	// the stop and the pivot row of the last column need to be inside of
+	// the matrix
+	if stopRow >= n || stopCol >= m || startRow+stopCol-startCol >= n {
+		return ErrIndexOutOfRange
+	}
+
+	return nil
 }
 
 func (f *F2) partialDiagonalize(startRow, startCol, stopRow, stopCol int, gaussMatrix *F2) *F2 {
 	// iterate backwards through the pivot bits
 	for pivotBit := stopCol; pivotBit >= startCol; pivotBit-- {
-		// choose each row from the top row to the one with the pivot bit
-		for rowCounter := startRow; rowCounter < stopRow; rowCounter++ {
-			// prevent xor with the row itself
-			if rowCounter == startRow+pivotBit-startCol {
-				continue
-			}
+		// choose each row from the top row to the one with the pivot bit and
+		// process them concurrently
+		parallelRows(stopRow-startRow, func(start, stop int) {
+			for rowCounter := startRow + start; rowCounter < startRow+stop; rowCounter++ {
+				// prevent xor with the row itself
+				if rowCounter == startRow+pivotBit-startCol {
+					continue
+				}
 
-			// if the bit in the same position at the other row is 0...
-			if f.Rows[rowCounter].Bit(pivotBit) == uint(0) {
-				// ...continue to the next row
-				continue
-			}
+				// if the bit in the same position at the other row is 0...
+				if f.Rows[rowCounter].Bit(pivotBit) == uint(0) {
+					// ...continue to the next row
+					continue
+				}
 
-			// eliminate the 1
-			f.Rows[rowCounter].Xor(
-				f.Rows[rowCounter],
-				f.Rows[startRow+pivotBit-startCol],
-			)
+				// eliminate the 1
+				f.Rows[rowCounter].Xor(
+					f.Rows[rowCounter],
+					f.Rows[startRow+pivotBit-startCol],
+				)
 
-			if gaussMatrix == nil {
-				continue
-			}
+				if gaussMatrix == nil {
+					continue
+				}
 
-			// eliminate the 1
-			gaussMatrix.Rows[rowCounter].Xor(
-				gaussMatrix.Rows[rowCounter],
-				gaussMatrix.Rows[startRow+pivotBit-startCol],
-			)
-		}
+				// eliminate the 1
+				gaussMatrix.Rows[rowCounter].Xor(
+					gaussMatrix.Rows[rowCounter],
+					gaussMatrix.Rows[startRow+pivotBit-startCol],
+				)
+			}
+		})
 	}
 
 	return gaussMatrix
@@ -153,9 +366,112 @@ func (f *F2) PartialGaussianWithLinearChecking(
 	linearCheck func(*F2, *F2, *F2, int, int, int, int, int) (*F2, *F2, error),
 ) (*F2, *F2, error) {
 	// initialize the permutation matrix
-	gaussMatrix := NewF2(f.N, f.N).SetToIdentity()
 	permutationMatrix := NewF2(f.M, f.M).SetToIdentity()
 
+	// perform the elimination
+	gaussMatrix, err := f.partialGaussianWithLinearChecking(
+		startRow,
+		startCol,
+		stopRow,
+		stopCol,
+		func(gaussMatrix *F2, pivotBit int) (*F2, error) {
+			// initialize the error
+			var err error
+
+			// detect linear dependencies and try to resolve them
+			gaussMatrix, permutationMatrix, err = linearCheck(
+				f,
+				gaussMatrix,
+				permutationMatrix,
+				startRow,
+				startCol,
+				stopRow,
+				stopCol,
+				pivotBit,
+			)
+
+			return gaussMatrix, err
+		},
+	)
+
+	// check the error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return gaussMatrix, permutationMatrix, nil
+}
+
+// PartialGaussianWithPermutation performs a partial gaussian elimination
+//
+// This function works like PartialGaussianWithLinearChecking, but the column
+// swaps of the linearCheck callback are recorded in a Permutation instead of
+// a permutation matrix. For the linearCheck callback take a look at the
+// function LinearDependenciesInGaussWithPermutation of the resolver package.
+func (f *F2) PartialGaussianWithPermutation(
+	startRow int,
+	startCol int,
+	stopRow int,
+	stopCol int,
+	linearCheck func(*F2, *F2, Permutation, int, int, int, int, int) (*F2, Permutation, error),
+) (*F2, Permutation, error) {
+	// initialize the permutation
+	permutation := NewPermutation(f.M)
+
+	// perform the elimination
+	gaussMatrix, err := f.partialGaussianWithLinearChecking(
+		startRow,
+		startCol,
+		stopRow,
+		stopCol,
+		func(gaussMatrix *F2, pivotBit int) (*F2, error) {
+			// initialize the error
+			var err error
+
+			// detect linear dependencies and try to resolve them
+			gaussMatrix, permutation, err = linearCheck(
+				f,
+				gaussMatrix,
+				permutation,
+				startRow,
+				startCol,
+				stopRow,
+				stopCol,
+				pivotBit,
+			)
+
+			return gaussMatrix, err
+		},
+	)
+
+	// check the error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return gaussMatrix, permutation, nil
+}
+
+// partialGaussianWithLinearChecking performs the partial gaussian elimination
+// of PartialGaussianWithLinearChecking
+//
+// The linearCheck callback is called with the current transformation matrix
+// and the pivot bit, for which no row could be found.
+func (f *F2) partialGaussianWithLinearChecking(
+	startRow int,
+	startCol int,
+	stopRow int,
+	stopCol int,
+	linearCheck func(*F2, int) (*F2, error),
+) (*F2, error) {
+	// verify the range
+	if err := checkGaussRange(f.N, f.M, startRow, startCol, stopRow, stopCol); err != nil {
+		return nil, err
+	}
+
+	// initialize the transformation matrix
+	gaussMatrix := NewF2(f.N, f.N).SetToIdentity()
+
 	// initialize the error vector
 	var err error
 
@@ -210,20 +526,11 @@ func (f *F2) PartialGaussianWithLinearChecking(
 		}
 
 		// detect linear dependencies and try to resolve them
-		gaussMatrix, permutationMatrix, err = linearCheck(
-			f,
-			gaussMatrix,
-			permutationMatrix,
-			startRow,
-			startCol,
-			stopRow,
-			stopCol,
-			pivotBit,
-		)
+		gaussMatrix, err = linearCheck(gaussMatrix, pivotBit)
 
 		// check the error
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 
 		// process the same row again
@@ -233,17 +540,24 @@ func (f *F2) PartialGaussianWithLinearChecking(
 	// do the same thing backwards to get the identity matrix
 	gaussMatrix = f.partialDiagonalize(startRow, startCol, stopRow, stopCol, gaussMatrix)
 
-	return gaussMatrix, permutationMatrix, nil
+	return gaussMatrix, nil
 }
 
 // CheckGaussian checks if the given range in the matrix is the identity matrix
 //
+// If the range is not inside of the matrix, the check fails.
+//
 // @param int startRow The row where the check starts
 // @param int startCol The column where the check starts
 // @param int n        The size of the submatrix to check
 //
 // @return bool
 func (f *F2) CheckGaussian(startRow, startCol, n int) bool {
+	// verify the range
+	if startRow < 0 || startCol < 0 || n < 0 || startRow+n > f.N || startCol+n > f.M {
+		return false
+	}
+
 	counter := 0
 
 	// create the bitmask for the bits to check
@@ -292,3 +606,44 @@ func (f *F2) CheckGaussian(startRow, startCol, n int) bool {
 
 	return true
 }
+
+// Inverse returns the inverse of the matrix
+//
+// This function appends the identity matrix to a copy of f and applies the
+// gaussian elimination to the left half. If the left half results in the
+// identity matrix, the right half contains the inverse. f is not modified.
+//
+// @return *F2, error
+func (f *F2) Inverse() (*F2, error) {
+	// only square matrices can be inverted
+	if f.N != f.M {
+		return nil, ErrNotSquare
+	}
+
+	// create the matrix [f|I]
+	augmented := NewF2(f.N, 2*f.N)
+
+	// iterate through the rows
+	for i, row := range f.Rows {
+		// set the bit of the identity matrix
+		augmented.Rows[i].SetBit(augmented.Rows[i], f.N+i, 1)
+
+		// copy the row of f into the left half
+		augmented.Rows[i].Or(augmented.Rows[i], row)
+	}
+
+	// eliminate the left half, which applies the same row operations to the
+	// identity matrix on the right half
+	if err := augmented.PartialGaussianElimination(0, 0, f.N-1, f.N-1); err != nil {
+		return nil, err
+	}
+
+	// if the left half is not the identity matrix...
+	if !augmented.CheckGaussian(0, 0, f.N) {
+		// ...f has no inverse
+		return nil, ErrSingular
+	}
+
+	// return the right half
+	return augmented.GetSubMatrix(0, f.N, f.N, 2*f.N), nil
+}
@@ -436,3 +436,411 @@ func TestCheckGaussian(t *testing.T) {
 		assert.Equalf(t, test.expectedResult, result, test.description)
 	}
 }
+
+func TestInverse(t *testing.T) {
+	tests := []struct {
+		description   string
+		matrix        *F2
+		expectedError error
+	}{
+		{
+			description: "identity matrix",
+			matrix:      NewF2(3, 3).SetToIdentity(),
+		},
+		{
+			description: "3x3 matrix",
+			matrix:      NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(2)}),
+		},
+		{
+			description: "matrix that needs row swaps",
+			matrix:      NewF2(3, 3).Set([]*big.Int{big.NewInt(2), big.NewInt(5), big.NewInt(3)}),
+		},
+		{
+			description: "empty matrix",
+			matrix:      NewF2(0, 0),
+		},
+		{
+			description:   "singular matrix",
+			matrix:        NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(6)}),
+			expectedError: ErrSingular,
+		},
+		{
+			description:   "zero row",
+			matrix:        NewF2(2, 2).Set([]*big.Int{big.NewInt(0), big.NewInt(3)}),
+			expectedError: ErrSingular,
+		},
+		{
+			description:   "non square matrix",
+			matrix:        NewF2(2, 3),
+			expectedError: ErrNotSquare,
+		},
+	}
+
+	for _, test := range tests {
+		saved := NewF2(test.matrix.N, test.matrix.M).Set(test.matrix.Rows)
+
+		inverse, err := test.matrix.Inverse()
+
+		assert.Equalf(t, test.expectedError, err, test.description)
+		assert.Truef(t, saved.IsEqual(test.matrix), test.description)
+
+		if err != nil {
+			continue
+		}
+
+		identity := NewF2(test.matrix.N, test.matrix.N).SetToIdentity()
+
+		assert.Truef(t, identity.IsEqual(saved.MulMatrix(inverse)), test.description)
+		assert.Truef(t, identity.IsEqual(inverse.MulMatrix(test.matrix)), test.description)
+	}
+}
+
+func TestInverseRandom(t *testing.T) {
+	inverted := 0
+
+	for seed := int64(0); seed < 20; seed++ {
+		matrix := randomF2(40, 40, seed)
+
+		inverse, err := matrix.Inverse()
+		if err == ErrSingular {
+			continue
+		}
+
+		inverted++
+
+		assert.Nil(t, err)
+		assert.True(t, NewF2(40, 40).SetToIdentity().IsEqual(inverse.MulMatrix(matrix)))
+	}
+
+	assert.NotZero(t, inverted)
+}
+
+func TestGaussianEliminationRankDeficient(t *testing.T) {
+	tests := []struct {
+		description    string
+		matrixA        *F2
+		expectedMatrix *F2
+	}{
+		{
+			description:    "singular square matrix",
+			matrixA:        NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(6)}),
+			expectedMatrix: NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(6), big.NewInt(0)}),
+		},
+		{
+			description:    "wide matrix",
+			matrixA:        NewF2(2, 4).Set([]*big.Int{big.NewInt(12), big.NewInt(6)}),
+			expectedMatrix: NewF2(2, 4).Set([]*big.Int{big.NewInt(10), big.NewInt(12)}),
+		},
+		{
+			description:    "tall matrix",
+			matrixA:        NewF2(4, 2).Set([]*big.Int{big.NewInt(2), big.NewInt(3), big.NewInt(1), big.NewInt(0)}),
+			expectedMatrix: NewF2(4, 2).Set([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(0), big.NewInt(0)}),
+		},
+	}
+
+	for _, test := range tests {
+		test.matrixA.GaussianElimination()
+
+		assert.Truef(t, test.matrixA.IsEqual(test.expectedMatrix), test.description)
+	}
+}
+
+func TestReducedRowEchelonForm(t *testing.T) {
+	tests := []struct {
+		description     string
+		matrix          *F2
+		expectedProfile *RankProfile
+	}{
+		{
+			description: "identity matrix",
+			matrix:      NewF2(3, 3).SetToIdentity(),
+			expectedProfile: &RankProfile{
+				Rank:       3,
+				PivotCols:  []int{0, 1, 2},
+				FreeCols:   []int{},
+				RowProfile: []int{0, 1, 2},
+			},
+		},
+		{
+			description: "singular square matrix",
+			matrix:      NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(6)}),
+			expectedProfile: &RankProfile{
+				Rank:       2,
+				PivotCols:  []int{0, 1},
+				FreeCols:   []int{2},
+				RowProfile: []int{0, 1},
+			},
+		},
+		{
+			description: "dependent first rows",
+			matrix:      NewF2(3, 3).Set([]*big.Int{big.NewInt(6), big.NewInt(6), big.NewInt(1)}),
+			expectedProfile: &RankProfile{
+				Rank:       2,
+				PivotCols:  []int{0, 1},
+				FreeCols:   []int{2},
+				RowProfile: []int{0, 2},
+			},
+		},
+		{
+			description: "wide matrix",
+			matrix:      NewF2(2, 4).Set([]*big.Int{big.NewInt(12), big.NewInt(6)}),
+			expectedProfile: &RankProfile{
+				Rank:       2,
+				PivotCols:  []int{1, 2},
+				FreeCols:   []int{0, 3},
+				RowProfile: []int{0, 1},
+			},
+		},
+		{
+			description: "tall matrix",
+			matrix:      NewF2(4, 2).Set([]*big.Int{big.NewInt(0), big.NewInt(2), big.NewInt(2), big.NewInt(3)}),
+			expectedProfile: &RankProfile{
+				Rank:       2,
+				PivotCols:  []int{0, 1},
+				FreeCols:   []int{},
+				RowProfile: []int{1, 3},
+			},
+		},
+		{
+			description: "zero matrix",
+			matrix:      NewF2(2, 3),
+			expectedProfile: &RankProfile{
+				Rank:       0,
+				PivotCols:  []int{},
+				FreeCols:   []int{0, 1, 2},
+				RowProfile: []int{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		rank := test.matrix.Rank()
+		profile := test.matrix.ReducedRowEchelonForm()
+
+		assert.Equalf(t, test.expectedProfile, profile, test.description)
+		assert.Equalf(t, profile.Rank, rank, test.description)
+
+		// every pivot column needs to be a unit vector
+		for i, col := range profile.PivotCols {
+			expectedCol := big.NewInt(0).SetBit(big.NewInt(0), i, 1)
+
+			assert.Zerof(t, expectedCol.Cmp(test.matrix.GetCol(col)), test.description)
+		}
+	}
+}
+
+func TestRankRandom(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		matrix := randomF2(30, 50, seed)
+
+		rank := matrix.Rank()
+
+		assert.Equal(t, rank, NewF2(matrix.N, matrix.M).Set(matrix.Rows).T().Rank())
+		assert.Equal(t, rank, matrix.ToPacked().Rank())
+		assert.Equal(t, rank, len(matrix.ReducedRowEchelonForm().RowProfile))
+	}
+}
+
+func TestKernel(t *testing.T) {
+	tests := []struct {
+		description   string
+		matrix        *F2
+		expectedRows  int
+		expectedLeft  int
+		expectedBasis *F2
+	}{
+		{
+			description:   "singular square matrix",
+			matrix:        NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(6)}),
+			expectedRows:  1,
+			expectedLeft:  1,
+			expectedBasis: NewF2(1, 3).Set([]*big.Int{big.NewInt(7)}),
+		},
+		{
+			description:   "invertible matrix",
+			matrix:        NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(2)}),
+			expectedRows:  0,
+			expectedLeft:  0,
+			expectedBasis: NewF2(0, 3),
+		},
+		{
+			description:  "wide matrix",
+			matrix:       NewF2(2, 5).Set([]*big.Int{big.NewInt(12), big.NewInt(6)}),
+			expectedRows: 3,
+			expectedLeft: 0,
+		},
+		{
+			description:  "tall matrix",
+			matrix:       NewF2(4, 2).Set([]*big.Int{big.NewInt(0), big.NewInt(2), big.NewInt(2), big.NewInt(3)}),
+			expectedRows: 0,
+			expectedLeft: 2,
+		},
+		{
+			description:  "random matrix",
+			matrix:       randomF2(30, 45, 3),
+			expectedRows: 15,
+			expectedLeft: 0,
+		},
+	}
+
+	for _, test := range tests {
+		saved := NewF2(test.matrix.N, test.matrix.M).Set(test.matrix.Rows)
+
+		kernel := test.matrix.Kernel()
+		leftKernel := test.matrix.LeftKernel()
+
+		assert.Truef(t, saved.IsEqual(test.matrix), test.description)
+
+		assert.Equalf(t, test.expectedRows, kernel.N, test.description)
+		assert.Equalf(t, test.matrix.M, kernel.M, test.description)
+		assert.Equalf(t, kernel.N, kernel.Rank(), test.description)
+
+		assert.Equalf(t, test.expectedLeft, leftKernel.N, test.description)
+		assert.Equalf(t, test.matrix.N, leftKernel.M, test.description)
+		assert.Equalf(t, leftKernel.N, leftKernel.Rank(), test.description)
+
+		if test.expectedBasis != nil {
+			assert.Truef(t, test.expectedBasis.IsEqual(kernel), test.description)
+		}
+
+		product := NewF2(saved.N, saved.M).Set(saved.Rows).MulMatrix(kernel.T())
+		assert.Truef(t, NewF2(saved.N, test.expectedRows).IsEqual(product), test.description)
+
+		leftProduct := leftKernel.MulMatrix(saved)
+		assert.Truef(t, NewF2(test.expectedLeft, saved.M).IsEqual(leftProduct), test.description)
+	}
+}
+
+func TestPartialGaussianWithPermutation(t *testing.T) {
+	matrix := NewF2(4, 4).Set([]*big.Int{
+		big.NewInt(10),
+		big.NewInt(13),
+		big.NewInt(0),
+		big.NewInt(1),
+	})
+	savedMatrix := NewF2(4, 4).Set(matrix.Rows)
+
+	linearCheck := func(f, gaussMatrix *F2, permutation Permutation, startRow, startCol, stopRow, stopCol, pivotBit int) (*F2, Permutation, error) {
+		// swap the last row into place
+		f.SwapRows(stopRow, f.N-1)
+		gaussMatrix.SwapRows(stopRow, f.N-1)
+
+		// swap the 1 of the last row into the pivot column
+		f.SwapCols(0, pivotBit)
+		permutation.Swap(0, pivotBit)
+
+		return gaussMatrix, permutation, nil
+	}
+
+	gaussMatrix, permutation, err := matrix.PartialGaussianWithPermutation(0, 1, 2, 3, linearCheck)
+
+	assert.Nil(t, err)
+	assert.Equal(t, Permutation{3, 1, 2, 0}, permutation)
+	assert.True(t, matrix.CheckGaussian(0, 1, 3))
+
+	// the transformation and the permutation create the processed matrix
+	expected := gaussMatrix.MulMatrix(savedMatrix).MulMatrix(permutation.ToMatrix())
+	assert.True(t, expected.IsEqual(matrix))
+
+	_, _, err = NewF2(4, 4).PartialGaussianWithPermutation(
+		0,
+		1,
+		2,
+		3,
+		func(f, gaussMatrix *F2, permutation Permutation, startRow, startCol, stopRow, stopCol, pivotBit int) (*F2, Permutation, error) {
+			return nil, nil, fmt.Errorf("testfoo")
+		},
+	)
+
+	assert.NotNil(t, err)
+}
+
+func TestPartialGaussianEliminationRange(t *testing.T) {
+	tests := []struct {
+		description string
+		startRow    int
+		startCol    int
+		stopRow     int
+		stopCol     int
+		err         error
+	}{
+		{
+			description: "complete matrix",
+			startRow:    0,
+			startCol:    0,
+			stopRow:     2,
+			stopCol:     2,
+		},
+		{
+			description: "empty range",
+			startRow:    0,
+			startCol:    0,
+			stopRow:     -1,
+			stopCol:     -1,
+		},
+		{
+			description: "stop row out of range",
+			startRow:    0,
+			startCol:    0,
+			stopRow:     3,
+			stopCol:     2,
+			err:         ErrIndexOutOfRange,
+		},
+		{
+			description: "negative start row",
+			startRow:    -1,
+			startCol:    0,
+			stopRow:     2,
+			stopCol:     2,
+			err:         ErrIndexOutOfRange,
+		},
+		{
+			description: "more columns than pivot rows",
+			startRow:    1,
+			startCol:    0,
+			stopRow:     2,
+			stopCol:     3,
+			err:         ErrIndexOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		matrix := randomF2(3, 4, 1)
+		packed := matrix.ToPacked()
+
+		err := matrix.PartialGaussianElimination(test.startRow, test.startCol, test.stopRow, test.stopCol)
+		assert.Equalf(t, test.err, err, test.description)
+
+		err = packed.PartialGaussianElimination(test.startRow, test.startCol, test.stopRow, test.stopCol)
+		assert.Equalf(t, test.err, err, test.description)
+
+		assert.Truef(t, matrix.IsEqual(packed.ToF2()), test.description)
+
+		if test.err != nil {
+			// the matrix is not modified on errors
+			assert.Truef(t, matrix.IsEqual(randomF2(3, 4, 1)), test.description)
+		}
+	}
+
+	_, _, err := NewF2(3, 3).PartialGaussianWithPermutation(0, 0, 3, 3, nil)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+
+	_, _, err = NewF2(3, 3).PartialGaussianWithLinearChecking(0, 0, 3, 3, nil)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+
+	_, _, err = NewPackedF2(3, 3).PartialGaussianWithLinearChecking(0, 0, 3, 3, nil)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+}
+
+func TestCheckGaussianRange(t *testing.T) {
+	matrix := NewF2(3, 3).SetToIdentity()
+	packed := matrix.ToPacked()
+
+	assert.True(t, matrix.CheckGaussian(0, 0, 3))
+	assert.False(t, matrix.CheckGaussian(1, 1, 3))
+	assert.False(t, matrix.CheckGaussian(-1, 0, 2))
+
+	assert.True(t, packed.CheckGaussian(0, 0, 3))
+	assert.False(t, packed.CheckGaussian(1, 1, 3))
+	assert.False(t, packed.CheckGaussian(-1, 0, 2))
+}
@@ -2,9 +2,7 @@
 package gomatrix
 
 import (
-	"fmt"
 	"math/big"
-	"math/rand"
 )
 
 // F2 represents a matrix with entries that contains 0 or 1
@@ -49,26 +47,63 @@ func NewF2(n, m int) *F2 {
 //
 // @return *F2|nil
 func (f *F2) Set(data []*big.Int) *F2 {
+	// set the data and drop the error
+	result, err := f.SetChecked(data)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+// SetChecked sets data from the data array
+//
+// This function works like Set, but it returns ErrDimensionMismatch instead
+// of nil if the data does not fit into the matrix. In this case, the matrix
+// is not modified.
+//
+// @param []*big.Int data The data to insert into the matrix
+//
+// @return *F2, error
+func (f *F2) SetChecked(data []*big.Int) (*F2, error) {
 	// if the size is different...
 	if len(data) != f.N {
 		// ...return an error
-		return nil
+		return nil, ErrDimensionMismatch
 	}
 
-	// iterate through all given rows
-	for i, datum := range data {
+	// verify all rows before modifying the matrix
+	for _, datum := range data {
 		// if the size is different...
-		if datum.BitLen() > f.M {
+		if datum.Sign() < 0 || datum.BitLen() > f.M {
 			// ...return an error
-			return nil
+			return nil, ErrDimensionMismatch
 		}
+	}
 
+	// iterate through all given rows
+	for i, datum := range data {
 		// set the depending row
 		f.Rows[i] = new(big.Int).Set(datum)
 	}
 
 	// return success
-	return f
+	return f, nil
+}
+
+// Clone returns a deep copy of the matrix
+//
+// @return *F2
+func (f *F2) Clone() *F2 {
+	// create the matrix
+	clone := NewF2(f.N, f.M)
+
+	// copy the rows
+	for i, row := range f.Rows {
+		clone.Rows[i].Set(row)
+	}
+
+	return clone
 }
 
 // At returns the value at index i, j
@@ -79,8 +114,8 @@ func (f *F2) Set(data []*big.Int) *F2 {
 // @return int, error|nil
 func (f *F2) At(i, j int) (int, error) {
 	// check if the indice are in the matrix
-	if i >= f.N || j >= f.M {
-		return -1, fmt.Errorf("Index out of bounds")
+	if i < 0 || j < 0 || i >= f.N || j >= f.M {
+		return -1, ErrIndexOutOfRange
 	}
 
 	// create the bitmask for the value selection
@@ -98,6 +133,142 @@ func (f *F2) At(i, j int) (int, error) {
 	return result, nil
 }
 
+// SetAt sets the value at index i, j
+//
+// Any value other than 0 sets the entry to 1.
+//
+// @param int i The row index
+// @param int j The column index
+// @param int v The new value
+//
+// @return error
+func (f *F2) SetAt(i, j, v int) error {
+	// check if the indice are in the matrix
+	if i < 0 || j < 0 || i >= f.N || j >= f.M {
+		return ErrIndexOutOfRange
+	}
+
+	// normalize the value
+	if v != 0 {
+		v = 1
+	}
+
+	// set the bit
+	f.Rows[i].SetBit(f.Rows[i], j, uint(v))
+
+	return nil
+}
+
+// Flip toggles the value at index i, j
+//
+// @param int i The row index
+// @param int j The column index
+//
+// @return error
+func (f *F2) Flip(i, j int) error {
+	// check if the indice are in the matrix
+	if i < 0 || j < 0 || i >= f.N || j >= f.M {
+		return ErrIndexOutOfRange
+	}
+
+	// invert the bit
+	f.Rows[i].SetBit(f.Rows[i], j, f.Rows[i].Bit(j)^1)
+
+	return nil
+}
+
+// SetRow sets the row at index i
+//
+// The bit j of row is the value of the column j. The row is copied into the
+// matrix. If it does not fit into the matrix, ErrDimensionMismatch is
+// returned.
+//
+// @param int      i   The row index
+// @param *big.Int row The new row
+//
+// @return error
+func (f *F2) SetRow(i int, row *big.Int) error {
+	// check if the index is in the matrix
+	if i < 0 || i >= f.N {
+		return ErrIndexOutOfRange
+	}
+
+	// verify that the row fits into the matrix
+	if row.Sign() < 0 || row.BitLen() > f.M {
+		return ErrDimensionMismatch
+	}
+
+	// copy the row
+	f.Rows[i].Set(row)
+
+	return nil
+}
+
+// SetCol sets the column at index j
+//
+// The bit i of col is the value of the row i, which is the same layout that
+// GetCol returns. If the column does not fit into the matrix,
+// ErrDimensionMismatch is returned.
+//
+// @param int      j   The column index
+// @param *big.Int col The new column
+//
+// @return error
+func (f *F2) SetCol(j int, col *big.Int) error {
+	// check if the index is in the matrix
+	if j < 0 || j >= f.M {
+		return ErrIndexOutOfRange
+	}
+
+	// verify that the column fits into the matrix
+	if col.Sign() < 0 || col.BitLen() > f.N {
+		return ErrDimensionMismatch
+	}
+
+	// set the bit of each row
+	for i, row := range f.Rows {
+		row.SetBit(row, j, col.Bit(i))
+	}
+
+	return nil
+}
+
+// ZeroRow sets all values of the row at index i to 0
+//
+// @param int i The row index
+//
+// @return error
+func (f *F2) ZeroRow(i int) error {
+	// check if the index is in the matrix
+	if i < 0 || i >= f.N {
+		return ErrIndexOutOfRange
+	}
+
+	// reset the row
+	f.Rows[i].SetInt64(0)
+
+	return nil
+}
+
+// ZeroCol sets all values of the column at index j to 0
+//
+// @param int j The column index
+//
+// @return error
+func (f *F2) ZeroCol(j int) error {
+	// check if the index is in the matrix
+	if j < 0 || j >= f.M {
+		return ErrIndexOutOfRange
+	}
+
+	// reset the bit of each row
+	for _, row := range f.Rows {
+		row.SetBit(row, j, 0)
+	}
+
+	return nil
+}
+
 // IsEqual checks the equality of the matrix objects
 //
 // This function compares the values of the matrix that is given with the matrix
@@ -164,31 +335,14 @@ func (f *F2) T() *F2 {
 //
 // @return error
 func (f *F2) PartialT(startRow, startCol, n int) error {
-	// verify the given parameters
-	if startRow+n > f.N || startCol+n > f.M {
-		return fmt.Errorf("Cannot partially transpose a non square matrix")
+	// get the view of the submatrix to transpose
+	view, err := f.View(startRow, startCol, startRow+n, startCol+n)
+	if err != nil {
+		return err
 	}
 
-	// get the submatrix to transpose
-	subMatrix := f.GetSubMatrix(
-		startRow,
-		startCol,
-		startRow+n,
-		startCol+n,
-	)
-
-	// transpose the submatrix
-	subMatrix.T()
-
-	// set the transposed submatrix into f
-	f.SetSubMatrix(
-		subMatrix,
-		startRow,
-		startCol,
-	)
-
-	// return success
-	return nil
+	// transpose the submatrix in place
+	return view.T()
 }
 
 // transposeRowToColumn transpose the row into the specified column
@@ -242,7 +396,7 @@ func (f *F2) SetToIdentity() *F2 {
 func (f *F2) SwapRows(i, j int) error {
 	// check for input parameters
 	if i >= f.N || j >= f.N || i < 0 || j < 0 {
-		return fmt.Errorf("Index does not exist")
+		return ErrIndexOutOfRange
 	}
 	// swap the rows
 	f.Rows[i], f.Rows[j] = f.Rows[j], f.Rows[i]
@@ -260,7 +414,7 @@ func (f *F2) SwapRows(i, j int) error {
 func (f *F2) SwapCols(i, j int) error {
 	// check for input parameters
 	if i >= f.M || j >= f.M || i < 0 || j < 0 {
-		return fmt.Errorf("Index does not exist")
+		return ErrIndexOutOfRange
 	}
 
 	// iterate through the rows
@@ -282,35 +436,13 @@ func (f *F2) SwapCols(i, j int) error {
 //
 // This function swaps columns randomly. The swap operation will be repeated
 // on every column. After swapping the columns, the permutation
-// matrix will be returned.
+// matrix will be returned. The swaps are chosen with crypto/rand, use
+// PermuteColsFrom for another source of random bytes.
 //
 // @return *F2
 func (f *F2) PermuteCols() *F2 {
-	// initialize the permuation matrix
-	permutationMatrix := NewF2(f.M, f.M)
-
-	// set the permutation matrix to the identity matrix of f
-	permutationMatrix.SetToIdentity()
-
-	// repeat the swaps
-	for i := 0; i < f.M; i++ {
-		// get a random column destination index
-		j := rand.Intn(f.M-i) + i
-
-		// check if the column is swapped or not
-		if i == j {
-			continue
-		}
-
-		// swap the columns in f
-		f.SwapCols(i, j)
-
-		// swap the columns in the permutation matrix
-		permutationMatrix.SwapCols(i, j)
-	}
-
-	// return the permutation matrix
-	return permutationMatrix
+	// permute the columns and convert the permutation
+	return f.PermuteColsCompact().ToMatrix()
 }
 
 // GetCol returns the column at index i
@@ -322,10 +454,27 @@ func (f *F2) PermuteCols() *F2 {
 //
 // @return *big.Int
 func (f *F2) GetCol(i int) *big.Int {
+	// get the column and drop the error
+	col, err := f.GetColChecked(i)
+	if err != nil {
+		return nil
+	}
+
+	return col
+}
+
+// GetColChecked returns the column at index i
+//
+// This function works like GetCol, but it returns ErrIndexOutOfRange instead
+// of nil if an invalid index is used.
+//
+// @param int i The index for the column
+//
+// @return *big.Int, error
+func (f *F2) GetColChecked(i int) (*big.Int, error) {
 	// check for input parameters
 	if i < 0 || i >= f.M {
-		// return nil
-		return nil
+		return nil, ErrIndexOutOfRange
 	}
 
 	// initialize the output big.Int
@@ -338,16 +487,47 @@ func (f *F2) GetCol(i int) *big.Int {
 	}
 
 	// return the result
-	return output
+	return output, nil
 }
 
-// GetSubMatrix gets the submatrix with boundaries included
+// GetSubMatrix gets the submatrix with the stop boundaries excluded
+//
+// If the boundaries are not inside of the matrix, nil is returned.
 //
 // @param int startRow The first row to include
 // @param int startCol The first column to include
-// @param int stopRow  The last row to include
-// @param int stopCol  The last column to include
+// @param int stopRow  The row after the last row to include
+// @param int stopCol  The column after the last column to include
+//
+// @return *F2|nil
 func (f *F2) GetSubMatrix(startRow, startCol, stopRow, stopCol int) *F2 {
+	// get the submatrix and drop the error
+	output, err := f.GetSubMatrixChecked(startRow, startCol, stopRow, stopCol)
+	if err != nil {
+		return nil
+	}
+
+	return output
+}
+
+// GetSubMatrixChecked gets the submatrix with the stop boundaries excluded
+//
+// This function works like GetSubMatrix, but it returns ErrIndexOutOfRange
+// instead of nil if the boundaries are not inside of the matrix.
+//
+// @param int startRow The first row to include
+// @param int startCol The first column to include
+// @param int stopRow  The row after the last row to include
+// @param int stopCol  The column after the last column to include
+//
+// @return *F2, error
+func (f *F2) GetSubMatrixChecked(startRow, startCol, stopRow, stopCol int) (*F2, error) {
+	// verify the boundaries
+	if startRow < 0 || startCol < 0 || stopRow > f.N || stopCol > f.M ||
+		startRow > stopRow || startCol > stopCol {
+		return nil, ErrIndexOutOfRange
+	}
+
 	// create the output matrix
 	output := NewF2(stopRow-startRow, stopCol-startCol)
 
@@ -384,7 +564,7 @@ func (f *F2) GetSubMatrix(startRow, startCol, stopRow, stopCol int) *F2 {
 	}
 
 	// return the matrix with the rows set
-	return output.Set(rows)
+	return output.SetChecked(rows)
 }
 
 // SetSubMatrix sets the submatrix into the current matrix
@@ -395,9 +575,14 @@ func (f *F2) GetSubMatrix(startRow, startCol, stopRow, stopCol int) *F2 {
 //
 // @return *F2, error
 func (f *F2) SetSubMatrix(m *F2, startRow, startCol int) (*F2, error) {
+	// verify the position of the submatrix
+	if startRow < 0 || startCol < 0 {
+		return nil, ErrIndexOutOfRange
+	}
+
 	// verify that the dimensions fit
 	if (m.N+startRow) > f.N || (m.M+startCol) > f.M {
-		return nil, fmt.Errorf("Submatrix too large")
+		return nil, ErrDimensionMismatch
 	}
 
 	// create the bitmask
@@ -411,36 +596,19 @@ func (f *F2) SetSubMatrix(m *F2, startRow, startCol int) (*F2, error) {
 	// shift the bitmask to the correct position
 	bitMask.Lsh(bitMask, uint(startCol))
 
-	// create the output matrix
-	output := NewF2(f.N, f.M).Set(f.Rows)
+	// initialize the buffer for the shifted rows of the submatrix
+	shifted := big.NewInt(0)
 
-	// iterate through the rows
-	for i := startRow; i < (m.N + startRow); i++ {
-		// get the bits that are going to be replaced from the current matrix
-		subBitMask := big.NewInt(0).And(
-			f.Rows[i],
-			bitMask,
-		)
-
-		// xor the bits with themselves in order to set the to 0
-		subBitMask.Xor(
-			f.Rows[i],
-			subBitMask,
-		)
+	// replace the bits directly in the rows of f
+	for i, row := range m.Rows {
+		// shift the row of the submatrix to the correct position
+		shifted.Lsh(row, uint(startCol)).And(shifted, bitMask)
 
-		// xor the shifted bits from the submatrix into the "erased" space
-		outputBits := big.NewInt(0).Xor(
-			subBitMask,
-			big.NewInt(0).Lsh(m.Rows[i-startRow], uint(startCol)),
-		)
-
-		// save the row
-		output.Rows[i] = outputBits
+		// erase the replaced bits and insert the new ones
+		target := f.Rows[startRow+i]
+		target.AndNot(target, bitMask).Or(target, shifted)
 	}
 
-	// set the rows back into f
-	f.Set(output.Rows)
-
 	// return success
 	return f, nil
 }
@@ -109,6 +109,14 @@ func TestAt(t *testing.T) {
 			expectedError:  true,
 			expectedResult: 0,
 		},
+		{
+			description:    "negative i",
+			matrix:         NewF2(2, 2).Set([]*big.Int{big.NewInt(2), big.NewInt(1)}),
+			i:              -1,
+			j:              0,
+			expectedError:  true,
+			expectedResult: 0,
+		},
 	}
 
 	for _, test := range tests {
@@ -535,3 +543,235 @@ func TestSetSubMatrix(t *testing.T) {
 		assert.Truef(t, test.expectedMatrix.IsEqual(result), test.description)
 	}
 }
+
+func TestSetChecked(t *testing.T) {
+	tests := []struct {
+		description string
+		data        []*big.Int
+		err         error
+	}{
+		{
+			description: "success",
+			data:        []*big.Int{big.NewInt(3), big.NewInt(1)},
+		},
+		{
+			description: "wrong count of rows",
+			data:        []*big.Int{big.NewInt(3)},
+			err:         ErrDimensionMismatch,
+		},
+		{
+			description: "row too large",
+			data:        []*big.Int{big.NewInt(3), big.NewInt(4)},
+			err:         ErrDimensionMismatch,
+		},
+		{
+			description: "negative row",
+			data:        []*big.Int{big.NewInt(3), big.NewInt(-1)},
+			err:         ErrDimensionMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		matrix := NewF2(2, 2)
+
+		result, err := matrix.SetChecked(test.data)
+
+		assert.Equalf(t, test.err, err, test.description)
+
+		if test.err != nil {
+			// the matrix is not modified on errors
+			assert.Nilf(t, result, test.description)
+			assert.Truef(t, matrix.IsEqual(NewF2(2, 2)), test.description)
+			continue
+		}
+
+		assert.Equalf(t, matrix, result, test.description)
+	}
+}
+
+func TestGetColChecked(t *testing.T) {
+	matrix := NewF2(2, 2).Set([]*big.Int{big.NewInt(3), big.NewInt(1)})
+
+	col, err := matrix.GetColChecked(1)
+	assert.Nil(t, err)
+	assert.Zero(t, big.NewInt(1).Cmp(col))
+
+	_, err = matrix.GetColChecked(2)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+
+	_, err = matrix.GetColChecked(-1)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+}
+
+func TestGetSubMatrixChecked(t *testing.T) {
+	matrix := NewF2(3, 3).Set([]*big.Int{big.NewInt(7), big.NewInt(5), big.NewInt(1)})
+
+	tests := []struct {
+		description string
+		startRow    int
+		startCol    int
+		stopRow     int
+		stopCol     int
+		err         error
+	}{
+		{
+			description: "success",
+			startRow:    1,
+			startCol:    1,
+			stopRow:     3,
+			stopCol:     3,
+		},
+		{
+			description: "stop row out of range",
+			startRow:    1,
+			startCol:    1,
+			stopRow:     4,
+			stopCol:     3,
+			err:         ErrIndexOutOfRange,
+		},
+		{
+			description: "negative start column",
+			startRow:    0,
+			startCol:    -1,
+			stopRow:     3,
+			stopCol:     3,
+			err:         ErrIndexOutOfRange,
+		},
+		{
+			description: "stop before start",
+			startRow:    2,
+			startCol:    0,
+			stopRow:     1,
+			stopCol:     3,
+			err:         ErrIndexOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := matrix.GetSubMatrixChecked(test.startRow, test.startCol, test.stopRow, test.stopCol)
+
+		assert.Equalf(t, test.err, err, test.description)
+		assert.Equalf(t, test.err != nil, result == nil, test.description)
+		assert.Equalf(
+			t,
+			test.err != nil,
+			matrix.GetSubMatrix(test.startRow, test.startCol, test.stopRow, test.stopCol) == nil,
+			test.description,
+		)
+	}
+}
+
+func TestSetSubMatrixErrors(t *testing.T) {
+	matrix := NewF2(3, 3)
+
+	_, err := matrix.SetSubMatrix(NewF2(2, 2), 2, 0)
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	_, err = matrix.SetSubMatrix(NewF2(2, 2), -1, 0)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+
+	assert.Equal(t, ErrIndexOutOfRange, matrix.PartialT(2, 0, 2))
+	assert.Equal(t, ErrIndexOutOfRange, matrix.SwapRows(0, 3))
+	assert.Equal(t, ErrIndexOutOfRange, matrix.SwapCols(-1, 0))
+}
+
+func TestClone(t *testing.T) {
+	matrix := randomF2(5, 70, 1)
+	clone := matrix.Clone()
+
+	assert.True(t, matrix.IsEqual(clone))
+
+	// modifying the clone does not modify the matrix
+	clone.Rows[0].SetBit(clone.Rows[0], 0, clone.Rows[0].Bit(0)^1)
+	assert.False(t, matrix.IsEqual(clone))
+}
+
+func TestSetAtAndFlip(t *testing.T) {
+	matrix := NewF2(2, 70)
+
+	assert.Nil(t, matrix.SetAt(0, 1, 1))
+	assert.Nil(t, matrix.SetAt(1, 69, 5))
+	assert.Nil(t, matrix.Flip(0, 2))
+	assert.Nil(t, matrix.Flip(0, 1))
+
+	assert.Equal(t, 0, MustInt(matrix.At(0, 1)))
+	assert.Equal(t, 1, MustInt(matrix.At(0, 2)))
+	assert.Equal(t, 1, MustInt(matrix.At(1, 69)))
+
+	assert.Nil(t, matrix.SetAt(1, 69, 0))
+	assert.Equal(t, 0, matrix.Rows[1].BitLen())
+
+	tests := []struct {
+		description string
+		i           int
+		j           int
+	}{
+		{
+			description: "row out of range",
+			i:           2,
+			j:           0,
+		},
+		{
+			description: "column out of range",
+			i:           0,
+			j:           70,
+		},
+		{
+			description: "negative row",
+			i:           -1,
+			j:           0,
+		},
+		{
+			description: "negative column",
+			i:           0,
+			j:           -1,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equalf(t, ErrIndexOutOfRange, matrix.SetAt(test.i, test.j, 1), test.description)
+		assert.Equalf(t, ErrIndexOutOfRange, matrix.Flip(test.i, test.j), test.description)
+	}
+}
+
+func TestSetRowAndCol(t *testing.T) {
+	matrix := NewF2(3, 4).Set([]*big.Int{
+		big.NewInt(10),
+		big.NewInt(7),
+		big.NewInt(4),
+	})
+
+	row := big.NewInt(9)
+
+	assert.Nil(t, matrix.SetRow(0, row))
+	assert.Nil(t, matrix.SetCol(3, big.NewInt(6)))
+
+	// the row is copied into the matrix
+	row.SetInt64(0)
+
+	expected := NewF2(3, 4).Set([]*big.Int{
+		big.NewInt(1),
+		big.NewInt(15),
+		big.NewInt(12),
+	})
+
+	assert.True(t, matrix.IsEqual(expected))
+
+	assert.Nil(t, matrix.ZeroRow(1))
+	assert.Nil(t, matrix.ZeroCol(2))
+
+	expected = NewF2(3, 4).Set([]*big.Int{
+		big.NewInt(1),
+		big.NewInt(0),
+		big.NewInt(8),
+	})
+
+	assert.True(t, matrix.IsEqual(expected))
+
+	assert.Equal(t, ErrIndexOutOfRange, matrix.SetRow(3, big.NewInt(1)))
+	assert.Equal(t, ErrDimensionMismatch, matrix.SetRow(0, big.NewInt(16)))
+	assert.Equal(t, ErrIndexOutOfRange, matrix.SetCol(-1, big.NewInt(1)))
+	assert.Equal(t, ErrDimensionMismatch, matrix.SetCol(0, big.NewInt(8)))
+	assert.Equal(t, ErrIndexOutOfRange, matrix.ZeroRow(-1))
+	assert.Equal(t, ErrIndexOutOfRange, matrix.ZeroCol(4))
+}
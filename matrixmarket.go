@@ -0,0 +1,275 @@
+package gomatrix
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// matrixMarketHeader is the header that is written by WriteMatrixMarket
+const matrixMarketHeader = "%%MatrixMarket matrix coordinate pattern general"
+
+// matrixMarketRowsPerByte bounds the count of rows of a matrix that is read
+// from the MatrixMarket format. Rows without entries need no lines, so the
+// count of rows may exceed the count of entries by at most this factor times
+// the size of the input.
+const matrixMarketRowsPerByte = 8
+
+// ParseMatrixMarket reads a matrix in the MatrixMarket coordinate format
+//
+// See ParseMatrixMarketSparse for the format.
+//
+// @param io.Reader r The reader to read the matrix from
+//
+// @return *F2, error
+func ParseMatrixMarket(r io.Reader) (*F2, error) {
+	// read the sparse matrix
+	s, err := ParseMatrixMarketSparse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ToF2(), nil
+}
+
+// ParseMatrixMarketSparse reads a matrix in the MatrixMarket coordinate
+// format
+//
+// The header needs to be "%%MatrixMarket matrix coordinate" with the field
+// pattern or integer and the symmetry general. Comments start with '%'. The
+// size line contains the count of rows, columns and entries, followed by
+// one line with the 1-based row and column of each entry. Entries of
+// integer matrices are reduced modulo 2 and repeated entries are added,
+// repeated entries of pattern matrices are not allowed. The rows are only
+// created after all entries were read, and their count may exceed the count
+// of entries by at most matrixMarketRowsPerByte times the size of the input,
+// so a forged size line cannot allocate arbitrary amounts of memory.
+// Malformed input is reported as *ParseError.
+//
+// @param io.Reader r The reader to read the matrix from
+//
+// @return *SparseF2, error
+func ParseMatrixMarketSparse(r io.Reader) (*SparseF2, error) {
+	// read the lines
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify the header
+	if len(lines) == 0 {
+		return nil, endOfInput(lines)
+	}
+
+	header := strings.Fields(strings.ToLower(lines[0]))
+	if len(header) != 5 || header[0] != "%%matrixmarket" || header[1] != "matrix" ||
+		header[2] != "coordinate" || header[4] != "general" ||
+		(header[3] != "pattern" && header[3] != "integer") {
+		return nil, &ParseError{Line: 1, Col: 1, Msg: "unsupported header"}
+	}
+
+	// integer matrices have a value for each entry
+	values := 2
+	if header[3] == "integer" {
+		values = 3
+	}
+
+	// read the remaining lines and skip the comments
+	var size []numberToken
+	entries := 0
+	ones := [][2]int{}
+	seen := map[[2]int]bool{}
+	inputSize := 0
+
+	for k := 1; k < len(lines); k++ {
+		// count the size of the input
+		inputSize += len(lines[k]) + 1
+
+		// skip the comments and empty lines
+		line := strings.TrimSpace(lines[k])
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		// the first line is the size line
+		if size == nil {
+			size, err = parseNumbers(lines[k], k+1)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(size) != 3 {
+				return nil, &ParseError{Line: k + 1, Col: 1, Msg: "expected rows, columns and entries"}
+			}
+
+			continue
+		}
+
+		// the count of entries is limited
+		if entries == size[2].value {
+			return nil, &ParseError{Line: k + 1, Col: 1, Msg: "too many entries"}
+		}
+		entries++
+
+		// read the entry
+		entry, err := parseMatrixMarketEntry(lines[k], k+1, values, size[0].value, size[1].value)
+		if err != nil {
+			return nil, err
+		}
+
+		// repeated entries of pattern matrices are not allowed
+		i, j := entry[0].value-1, entry[1].value-1
+		if values == 2 {
+			if seen[[2]int{i, j}] {
+				return nil, &ParseError{Line: k + 1, Col: entry[0].col, Msg: "duplicate entry"}
+			}
+
+			seen[[2]int{i, j}] = true
+		}
+
+		// entries with even values are 0
+		if values == 3 && entry[2].value%2 == 0 {
+			continue
+		}
+
+		ones = append(ones, [2]int{i, j})
+	}
+
+	// verify that all entries were read
+	if size == nil || entries < size[2].value {
+		return nil, endOfInput(lines)
+	}
+
+	// the rows without entries are bound by the size of the input
+	if size[0].value-entries > matrixMarketRowsPerByte*(len(lines[0])+1+inputSize) {
+		return nil, &ParseError{Line: size[0].line, Col: size[0].col, Msg: "too many rows for the input"}
+	}
+
+	// create the matrix and set the ones
+	s := NewSparseF2(size[0].value, size[1].value)
+	for _, one := range ones {
+		s.Rows[one[0]] = append(s.Rows[one[0]], one[1])
+	}
+
+	// sort the rows and remove the entries that cancel out
+	for i, row := range s.Rows {
+		s.Rows[i] = cancelPairs(row)
+	}
+
+	return s, nil
+}
+
+// WriteMatrixMarket writes the matrix in the MatrixMarket coordinate format
+//
+// See SparseF2.WriteMatrixMarket for the format.
+//
+// @param io.Writer w The writer to write the matrix to
+//
+// @return error
+func (f *F2) WriteMatrixMarket(w io.Writer) error {
+	return f.ToSparse().WriteMatrixMarket(w)
+}
+
+// WriteMatrixMarket writes the matrix in the MatrixMarket coordinate format
+//
+// The matrix is written as pattern matrix with one line for each one in
+// row-major order. ParseMatrixMarketSparse reads the matrix back exactly,
+// unless almost all rows of a huge matrix are empty, see
+// matrixMarketRowsPerByte.
+//
+// @param io.Writer w The writer to write the matrix to
+//
+// @return error
+func (s *SparseF2) WriteMatrixMarket(w io.Writer) error {
+	// buffer the output, the writer keeps the first error
+	writer := bufio.NewWriter(w)
+
+	// write the header and the size line
+	writer.WriteString(matrixMarketHeader + "\n")
+	writeNumbers(writer, []int{s.N, s.M, s.Weight()}, 0)
+
+	// write the 1-based position of each one
+	for i, row := range s.Rows {
+		for _, j := range row {
+			writeNumbers(writer, []int{i + 1, j + 1}, 0)
+		}
+	}
+
+	return writer.Flush()
+}
+
+// parseMatrixMarketEntry reads the line of an entry
+//
+// @param string line   The line of the entry
+// @param int    lineNo The number of the line, starting at 1
+// @param int    values The count of numbers in the line
+// @param int    n      The count of rows
+// @param int    m      The count of columns
+//
+// @return []numberToken, error
+func parseMatrixMarketEntry(line string, lineNo, values, n, m int) ([]numberToken, error) {
+	// integer values may be negative, so only the indice are numbers
+	tokens := prettyTokens(line)
+	if len(tokens) != values {
+		return nil, &ParseError{Line: lineNo, Col: 1, Msg: "expected " + strconv.Itoa(values) + " values"}
+	}
+
+	entry, err := parseNumbers(line[:tokens[1].col+len(tokens[1].text)-1], lineNo)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify the indice
+	for k, max := range []int{n, m} {
+		if entry[k].value < 1 || entry[k].value > max {
+			return nil, &ParseError{Line: lineNo, Col: entry[k].col, Msg: "index out of range"}
+		}
+	}
+
+	// read the value
+	if values == 3 {
+		value, err := strconv.Atoi(tokens[2].text)
+		if err != nil {
+			return nil, &ParseError{
+				Line: lineNo,
+				Col:  tokens[2].col,
+				Msg:  "invalid number \"" + tokens[2].text + "\"",
+			}
+		}
+
+		entry = append(entry, numberToken{value: value, line: lineNo, col: tokens[2].col})
+	}
+
+	return entry, nil
+}
+
+// cancelPairs sorts the columns and removes the columns that occur an even
+// count of times
+//
+// @param []int row The columns of the row
+//
+// @return []int
+func cancelPairs(row []int) []int {
+	// sort the columns, so that equal columns are next to each other
+	sort.Ints(row)
+
+	// keep the columns that occur an odd count of times
+	result := row[:0]
+	for k := 0; k < len(row); {
+		// count the occurrences of the column
+		count := 1
+		for k+count < len(row) && row[k+count] == row[k] {
+			count++
+		}
+
+		if count%2 == 1 {
+			result = append(result, row[k])
+		}
+
+		k += count
+	}
+
+	return result
+}
@@ -0,0 +1,178 @@
+package gomatrix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatrixMarket(t *testing.T) {
+	expected := NewSparseF2(3, 4).Set([][]int{{0, 1}, {1, 2, 3}, {0}})
+
+	var buffer bytes.Buffer
+	assert.Nil(t, expected.WriteMatrixMarket(&buffer))
+	assert.Equal(t, "%%MatrixMarket matrix coordinate pattern general\n3 4 6\n1 1\n1 2\n2 2\n2 3\n2 4\n3 1\n", buffer.String())
+
+	tests := []struct {
+		description string
+		input       string
+	}{
+		{
+			description: "pattern with comments and unsorted entries",
+			input:       "%%MatrixMarket matrix coordinate pattern general\n% comment\n\n3 4 6\n3 1\n2 4\n1 2\n1 1\n2 3\n2 2\n",
+		},
+		{
+			description: "integer reduced modulo 2",
+			input:       "%%MatrixMarket matrix coordinate integer general\n3 4 8\n1 1 1\n1 2 3\n1 3 2\n2 2 -1\n2 3 1\n2 4 1\n3 1 1\n3 3 4\n",
+		},
+		{
+			description: "integer with repeated entries",
+			input:       "%%MatrixMarket Matrix Coordinate Integer General\n3 4 8\n1 1 1\n1 2 1\n1 3 1\n1 3 1\n2 2 1\n2 3 1\n2 4 1\n3 1 1\n",
+		},
+	}
+
+	for _, test := range tests {
+		result, err := ParseMatrixMarketSparse(strings.NewReader(test.input))
+
+		assert.Nilf(t, err, test.description)
+		assert.Truef(t, expected.IsEqual(result), test.description)
+	}
+}
+
+func TestMatrixMarketRoundTrip(t *testing.T) {
+	matrices := []*F2{
+		randomF2(20, 37, 1),
+		randomSparseF2(50, 100, 3, 2).ToF2(),
+		NewF2(3, 4),
+		NewF2(0, 0),
+	}
+
+	for _, matrix := range matrices {
+		var buffer bytes.Buffer
+		assert.Nil(t, matrix.WriteMatrixMarket(&buffer))
+
+		result, err := ParseMatrixMarket(&buffer)
+		assert.Nil(t, err)
+		assert.True(t, matrix.IsEqual(result))
+	}
+}
+
+func TestMatrixMarketManyRows(t *testing.T) {
+	// the count of rows is only bound by the input
+	matrix := NewSparseF2(1<<20+1, 2)
+	for i := range matrix.Rows {
+		matrix.Rows[i] = []int{i % 2}
+	}
+
+	var buffer bytes.Buffer
+	assert.Nil(t, matrix.WriteMatrixMarket(&buffer))
+
+	result, err := ParseMatrixMarketSparse(&buffer)
+	assert.Nil(t, err)
+	assert.True(t, matrix.IsEqual(result))
+
+	// a few rows without entries do not need any input
+	input := "%%MatrixMarket matrix coordinate pattern general\n400 2 1\n400 2\n"
+
+	result, err = ParseMatrixMarketSparse(strings.NewReader(input))
+	assert.Nil(t, err)
+	assert.Equal(t, 400, result.N)
+	assert.Equal(t, []int{1}, result.Rows[399])
+}
+
+func TestMatrixMarketError(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		line        int
+		col         int
+	}{
+		{
+			description: "empty input",
+			input:       "",
+			line:        1,
+			col:         1,
+		},
+		{
+			description: "unsupported field",
+			input:       "%%MatrixMarket matrix coordinate real general\n1 1 0\n",
+			line:        1,
+			col:         1,
+		},
+		{
+			description: "unsupported symmetry",
+			input:       "%%MatrixMarket matrix coordinate pattern symmetric\n1 1 0\n",
+			line:        1,
+			col:         1,
+		},
+		{
+			description: "invalid size line",
+			input:       "%%MatrixMarket matrix coordinate pattern general\n2 2\n",
+			line:        2,
+			col:         1,
+		},
+		{
+			description: "index out of range",
+			input:       "%%MatrixMarket matrix coordinate pattern general\n2 2 1\n1 3\n",
+			line:        3,
+			col:         3,
+		},
+		{
+			description: "duplicate pattern entry",
+			input:       "%%MatrixMarket matrix coordinate pattern general\n2 2 2\n1 2\n1 2\n",
+			line:        4,
+			col:         1,
+		},
+		{
+			description: "missing value",
+			input:       "%%MatrixMarket matrix coordinate integer general\n2 2 1\n1 2\n",
+			line:        3,
+			col:         1,
+		},
+		{
+			description: "invalid value",
+			input:       "%%MatrixMarket matrix coordinate integer general\n2 2 1\n1 2 x\n",
+			line:        3,
+			col:         5,
+		},
+		{
+			description: "too many entries",
+			input:       "%%MatrixMarket matrix coordinate pattern general\n2 2 1\n1 2\n2 1\n",
+			line:        4,
+			col:         1,
+		},
+		{
+			description: "missing entries",
+			input:       "%%MatrixMarket matrix coordinate pattern general\n2 2 2\n1 2\n",
+			line:        3,
+			col:         4,
+		},
+		{
+			description: "count of rows out of range of int",
+			input:       "%%MatrixMarket matrix coordinate pattern general\n9223372036854775807 1 0\n",
+			line:        2,
+			col:         1,
+		},
+		{
+			description: "huge count of rows",
+			input:       "%%MatrixMarket matrix coordinate pattern general\n4000000000 1 0\n",
+			line:        2,
+			col:         1,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := ParseMatrixMarket(strings.NewReader(test.input))
+		assert.Nilf(t, result, test.description)
+
+		parseErr, ok := err.(*ParseError)
+		if !assert.Truef(t, ok, test.description) {
+			continue
+		}
+
+		assert.Equalf(t, test.line, parseErr.Line, test.description)
+		assert.Equalf(t, test.col, parseErr.Col, test.description)
+	}
+}
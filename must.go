@@ -0,0 +1,64 @@
+package gomatrix
+
+import (
+	"math/big"
+)
+
+// Must returns the matrix or panics if err is not nil
+//
+// This helper wraps the error-returning functions for callers that prefer
+// panics, e.g. Must(f.MulMatrixChecked(m)).
+//
+// @param *F2   f   The matrix to return
+// @param error err The error to check
+//
+// @return *F2
+func Must(f *F2, err error) *F2 {
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
+
+// MustInt returns the value or panics if err is not nil
+//
+// @param int   value The value to return
+// @param error err   The error to check
+//
+// @return int
+func MustInt(value int, err error) int {
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// MustBigInt returns the number or panics if err is not nil
+//
+// @param *big.Int number The number to return
+// @param error    err    The error to check
+//
+// @return *big.Int
+func MustBigInt(number *big.Int, err error) *big.Int {
+	if err != nil {
+		panic(err)
+	}
+
+	return number
+}
+
+// MustVec returns the vector or panics if err is not nil
+//
+// @param *Vec  v   The vector to return
+// @param error err The error to check
+//
+// @return *Vec
+func MustVec(v *Vec, err error) *Vec {
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
@@ -0,0 +1,30 @@
+package gomatrix
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMust(t *testing.T) {
+	matrix := NewF2(2, 2).SetToIdentity()
+
+	assert.Equal(t, matrix, Must(matrix, nil))
+	assert.Equal(t, 1, MustInt(matrix.At(0, 0)))
+	assert.Zero(t, big.NewInt(1).Cmp(MustBigInt(matrix.GetColChecked(0))))
+	assert.Equal(t, int64(2), MustVec(matrix.RowVec(1)).Bits.Int64())
+
+	assert.PanicsWithValue(t, ErrDimensionMismatch, func() {
+		Must(NewF2(2, 2).MulMatrixChecked(NewF2(3, 3)))
+	})
+	assert.PanicsWithValue(t, ErrIndexOutOfRange, func() {
+		MustInt(matrix.At(2, 0))
+	})
+	assert.PanicsWithValue(t, ErrIndexOutOfRange, func() {
+		MustBigInt(matrix.GetColChecked(2))
+	})
+	assert.PanicsWithValue(t, ErrIndexOutOfRange, func() {
+		MustVec(matrix.RowVec(2))
+	})
+}
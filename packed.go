@@ -0,0 +1,652 @@
+package gomatrix
+
+import (
+	"io"
+	"math/big"
+	"math/bits"
+)
+
+// wordSize is the number of bits stored in one word of a PackedF2 row
+const wordSize = 64
+
+// PackedF2 represents a matrix with entries that contains 0 or 1
+//
+// In contrast to F2, each row is stored as a fixed number of 64 bit words.
+// The column j is stored at bit j%64 of the word j/64 in each row. All bits
+// beyond column M are always 0. Accessing single bits does not allocate any
+// memory, which makes this layout a lot faster for large matrices.
+type PackedF2 struct {
+	N    int
+	M    int
+	Rows [][]uint64
+}
+
+// NewPackedF2 creates a new word-packed matrix in F_2
+//
+// @param int n The count of rows
+// @param int m The count of columns
+//
+// @return *PackedF2
+func NewPackedF2(n, m int) *PackedF2 {
+	// get the count of words per row
+	words := wordCount(m)
+
+	// allocate the storage for all rows at once
+	storage := make([]uint64, n*words)
+
+	// initialize rows array
+	rows := make([][]uint64, n)
+
+	// slice the storage into the rows
+	for i := range rows {
+		rows[i] = storage[i*words : (i+1)*words : (i+1)*words]
+	}
+
+	// return the matrix
+	return &PackedF2{
+		N:    n,
+		M:    m,
+		Rows: rows,
+	}
+}
+
+// ToPacked converts the matrix into the word-packed representation
+//
+// @return *PackedF2
+func (f *F2) ToPacked() *PackedF2 {
+	// create the packed matrix and copy the rows into it
+	return NewPackedF2(f.N, f.M).Set(f.Rows)
+}
+
+// ToF2 converts the matrix into the big.Int representation
+//
+// @return *F2
+func (p *PackedF2) ToF2() *F2 {
+	// create the matrix and set the converted rows
+	return NewF2(p.N, p.M).Set(p.BigRows())
+}
+
+// BigRows returns the rows of the matrix as big.Int
+//
+// The returned rows have the same layout as the rows of F2, so that they can
+// be used by callers that process F2.Rows directly.
+//
+// @return []*big.Int
+func (p *PackedF2) BigRows() []*big.Int {
+	// initialize the rows
+	rows := make([]*big.Int, p.N)
+
+	// convert each row
+	for i, row := range p.Rows {
+		rows[i] = wordsToBig(row)
+	}
+
+	// return the result
+	return rows
+}
+
+// Set sets data from the data array
+//
+// @param []*big.Int data The data to insert into the matrix
+//
+// @return *PackedF2|nil
+func (p *PackedF2) Set(data []*big.Int) *PackedF2 {
+	// if the size is different...
+	if len(data) != p.N {
+		// ...return an error
+		return nil
+	}
+
+	// verify all rows before modifying the matrix
+	for _, datum := range data {
+		// if the row is negative or the size is different...
+		if datum.Sign() < 0 || datum.BitLen() > p.M {
+			// ...return an error
+			return nil
+		}
+	}
+
+	// iterate through all given rows
+	for i, datum := range data {
+		// set the depending row
+		bigToWords(datum, p.Rows[i])
+	}
+
+	// return success
+	return p
+}
+
+// At returns the value at index i, j
+//
+// @param int i The row index
+// @param int j The column index
+//
+// @return int, error|nil
+func (p *PackedF2) At(i, j int) (int, error) {
+	// check if the indice are in the matrix
+	if i < 0 || j < 0 || i >= p.N || j >= p.M {
+		return -1, ErrIndexOutOfRange
+	}
+
+	// return the bit
+	return int(wordBit(p.Rows[i], j)), nil
+}
+
+// IsEqual checks the equality of the matrix objects
+//
+// @param *PackedF2 m The matrix to compare with
+//
+// @return bool
+func (p *PackedF2) IsEqual(m *PackedF2) bool {
+	// compare the sizes
+	if p.N != m.N || p.M != m.M {
+		return false
+	}
+
+	// iterate through the rows
+	for i, row := range p.Rows {
+		// compare the words of the row
+		for w, word := range row {
+			if word != m.Rows[i][w] {
+				return false
+			}
+		}
+	}
+
+	// size and values are equal
+	return true
+}
+
+// T transposes matrix p
+func (p *PackedF2) T() *PackedF2 {
+	// create the result matrix
+	result := NewPackedF2(p.M, p.N)
+
+	// iterate through the rows
+	for i, row := range p.Rows {
+		// iterate through the set bits of the row
+		for w, word := range row {
+			for word != 0 {
+				// get the index of the lowest set bit
+				j := w*wordSize + bits.TrailingZeros64(word)
+
+				// set the transposed bit
+				setWordBit(result.Rows[j], i, 1)
+
+				// remove the lowest set bit
+				word &= word - 1
+			}
+		}
+	}
+
+	// save the result matrix
+	p.N, p.M, p.Rows = result.N, result.M, result.Rows
+
+	return p
+}
+
+// PartialT partially transpose the matrix
+//
+// This function partially transposes a matrix. The submatrix that is
+// transposed need to be a square matrix.
+//
+// @param int startRow The row to start
+// @param int startCol The column to start
+// @param int n        The size of the submatrix
+//
+// @return error
+func (p *PackedF2) PartialT(startRow, startCol, n int) error {
+	// verify the given parameters
+	if startRow < 0 || startCol < 0 || n < 0 || startRow+n > p.N || startCol+n > p.M {
+		return ErrIndexOutOfRange
+	}
+
+	// get the submatrix to transpose
+	subMatrix := p.GetSubMatrix(
+		startRow,
+		startCol,
+		startRow+n,
+		startCol+n,
+	)
+
+	// transpose the submatrix and set it into p
+	_, err := p.SetSubMatrix(
+		subMatrix.T(),
+		startRow,
+		startCol,
+	)
+
+	return err
+}
+
+// SetToIdentity sets the matrix to the identity matrix
+//
+// This function sets the identity matrix into p. If p is a non square matrix,
+// the remaining rows/columns will be set to 0.
+func (p *PackedF2) SetToIdentity() *PackedF2 {
+	// iterate through the rows
+	for i, row := range p.Rows {
+		// set the row to 0
+		clearWords(row)
+
+		// if the column/row counter is greater than the specified dimension...
+		if i >= p.M {
+			// ...skip the 1 value
+			continue
+		}
+
+		// set the i'th bit for the identity matrix
+		setWordBit(row, i, 1)
+	}
+
+	return p
+}
+
+// SwapRows swaps the row at index i with the row at index j
+//
+// @param int i The index of the first row to swap
+// @param int j The index of the second row to swap
+//
+// @return error
+func (p *PackedF2) SwapRows(i, j int) error {
+	// check for input parameters
+	if i >= p.N || j >= p.N || i < 0 || j < 0 {
+		return ErrIndexOutOfRange
+	}
+
+	// swap the rows
+	p.Rows[i], p.Rows[j] = p.Rows[j], p.Rows[i]
+
+	// return success
+	return nil
+}
+
+// SwapCols swaps the columns at index i with the column at index j
+//
+// @param int i The index of the first columns to swap
+// @param int j The index of the second columns to swap
+//
+// @return error
+func (p *PackedF2) SwapCols(i, j int) error {
+	// check for input parameters
+	if i >= p.M || j >= p.M || i < 0 || j < 0 {
+		return ErrIndexOutOfRange
+	}
+
+	// iterate through the rows
+	for _, row := range p.Rows {
+		// get the bits with the given index
+		bitI := wordBit(row, i)
+		bitJ := wordBit(row, j)
+
+		// set the swapped bits
+		setWordBit(row, i, bitJ)
+		setWordBit(row, j, bitI)
+	}
+
+	// return success
+	return nil
+}
+
+// XorRow adds the row at index j to the row at index i
+//
+// @param int i The index of the row that is modified
+// @param int j The index of the row that is added
+//
+// @return error
+func (p *PackedF2) XorRow(i, j int) error {
+	// check for input parameters
+	if i >= p.N || j >= p.N || i < 0 || j < 0 {
+		return ErrIndexOutOfRange
+	}
+
+	// add the rows
+	xorWords(p.Rows[i], p.Rows[j])
+
+	// return success
+	return nil
+}
+
+// PermuteCols permutes the columns of the matrix randomly
+//
+// This function moves the columns with a uniformly distributed permutation
+// from crypto/rand. After moving the columns, the permutation matrix will
+// be returned. If crypto/rand fails, the function panics.
+//
+// @return *PackedF2
+func (p *PackedF2) PermuteCols() *PackedF2 {
+	// permute the columns with crypto/rand
+	permutation, err := p.PermuteColsFrom(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	// initialize the permuation matrix
+	permutationMatrix := NewPackedF2(p.M, p.M)
+
+	// set the 1 of each column
+	for i, k := range permutation {
+		setWordBit(permutationMatrix.Rows[k], i, 1)
+	}
+
+	// return the permutation matrix
+	return permutationMatrix
+}
+
+// PermuteColsFrom permutes the columns of the matrix randomly
+//
+// The column permutation[i] is moved to the column i. The uniformly
+// distributed permutation is created from the given source of random bytes.
+// If rng is nil, crypto/rand is used.
+//
+// @param io.Reader rng The source of random bytes
+//
+// @return Permutation, error
+func (p *PackedF2) PermuteColsFrom(rng io.Reader) (Permutation, error) {
+	// create the permutation
+	permutation, err := RandomPermutation(p.M, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	// initialize the buffer for the permuted row
+	buffer := make([]uint64, wordCount(p.M))
+
+	// iterate through the rows
+	for _, row := range p.Rows {
+		// move the column permutation[i] to the column i
+		for i, k := range permutation {
+			setWordBit(buffer, i, wordBit(row, k))
+		}
+
+		// save the permuted row
+		copy(row, buffer)
+	}
+
+	return permutation, nil
+}
+
+// GetCol returns the column at index i
+//
+// This function returns the column as packed words after the index is
+// verified. If an invalid index is used, the function returns nil.
+//
+// @param int i The index for the column
+//
+// @return []uint64
+func (p *PackedF2) GetCol(i int) []uint64 {
+	// check for input parameters
+	if i < 0 || i >= p.M {
+		// return nil
+		return nil
+	}
+
+	// initialize the output words
+	output := make([]uint64, wordCount(p.N))
+
+	// iterate through the rows
+	for j, row := range p.Rows {
+		// set the corresponding bit
+		setWordBit(output, j, wordBit(row, i))
+	}
+
+	// return the result
+	return output
+}
+
+// GetSubMatrix gets the submatrix with the stop boundaries excluded
+//
+// If the boundaries are not inside of the matrix, nil is returned.
+//
+// @param int startRow The first row to include
+// @param int startCol The first column to include
+// @param int stopRow  The row after the last row to include
+// @param int stopCol  The column after the last column to include
+//
+// @return *PackedF2|nil
+func (p *PackedF2) GetSubMatrix(startRow, startCol, stopRow, stopCol int) *PackedF2 {
+	// get the submatrix and drop the error
+	output, err := p.GetSubMatrixChecked(startRow, startCol, stopRow, stopCol)
+	if err != nil {
+		return nil
+	}
+
+	return output
+}
+
+// GetSubMatrixChecked gets the submatrix with the stop boundaries excluded
+//
+// This function works like GetSubMatrix, but it returns ErrIndexOutOfRange
+// instead of nil if the boundaries are not inside of the matrix.
+//
+// @param int startRow The first row to include
+// @param int startCol The first column to include
+// @param int stopRow  The row after the last row to include
+// @param int stopCol  The column after the last column to include
+//
+// @return *PackedF2, error
+func (p *PackedF2) GetSubMatrixChecked(startRow, startCol, stopRow, stopCol int) (*PackedF2, error) {
+	// verify the boundaries
+	if startRow < 0 || startCol < 0 || stopRow > p.N || stopCol > p.M ||
+		startRow > stopRow || startCol > stopCol {
+		return nil, ErrIndexOutOfRange
+	}
+
+	// create the output matrix
+	output := NewPackedF2(stopRow-startRow, stopCol-startCol)
+
+	// iterate through the given rows
+	for i := startRow; i < stopRow; i++ {
+		// copy the bits into the output row
+		copyBits(output.Rows[i-startRow], 0, p.Rows[i], startCol, output.M)
+	}
+
+	// return the matrix
+	return output, nil
+}
+
+// SetSubMatrix sets the submatrix into the current matrix
+//
+// @param *PackedF2 m        The submatrix to use
+// @param int       startRow The first row to replace
+// @param int       startCol The first column to replace
+//
+// @return *PackedF2, error
+func (p *PackedF2) SetSubMatrix(m *PackedF2, startRow, startCol int) (*PackedF2, error) {
+	// verify the position of the submatrix
+	if startRow < 0 || startCol < 0 {
+		return nil, ErrIndexOutOfRange
+	}
+
+	// verify that the dimensions fit
+	if (m.N+startRow) > p.N || (m.M+startCol) > p.M {
+		return nil, ErrDimensionMismatch
+	}
+
+	// iterate through the rows of the submatrix
+	for i, row := range m.Rows {
+		// copy the bits into the matrix
+		copyBits(p.Rows[startRow+i], startCol, row, 0, m.M)
+	}
+
+	// return success
+	return p, nil
+}
+
+// wordCount returns the count of words needed to store n bits
+//
+// @param int n The count of bits
+//
+// @return int
+func wordCount(n int) int {
+	return (n + wordSize - 1) / wordSize
+}
+
+// wordBit returns the bit at index j of the words
+//
+// @param []uint64 words The words to read from
+// @param int      j     The index of the bit
+//
+// @return uint
+func wordBit(words []uint64, j int) uint {
+	return uint(words[j/wordSize]>>uint(j%wordSize)) & 1
+}
+
+// setWordBit sets the bit at index j of the words to b
+//
+// @param []uint64 words The words to modify
+// @param int      j     The index of the bit
+// @param uint     b     The value of the bit
+func setWordBit(words []uint64, j int, b uint) {
+	// create the mask for the bit
+	mask := uint64(1) << uint(j%wordSize)
+
+	// clear the bit
+	words[j/wordSize] &^= mask
+
+	// if the bit should be set...
+	if b != 0 {
+		// ...set it
+		words[j/wordSize] |= mask
+	}
+}
+
+// xorWords adds the words of src to dst
+//
+// @param []uint64 dst The words to modify
+// @param []uint64 src The words to add
+func xorWords(dst, src []uint64) {
+	for i, word := range src {
+		dst[i] ^= word
+	}
+}
+
+// clearWords sets all words to 0
+//
+// @param []uint64 words The words to clear
+func clearWords(words []uint64) {
+	for i := range words {
+		words[i] = 0
+	}
+}
+
+// copyBits copies n bits from src at srcOffset to dst at dstOffset
+//
+// @param []uint64 dst       The words to write to
+// @param int      dstOffset The first bit in dst
+// @param []uint64 src       The words to read from
+// @param int      srcOffset The first bit in src
+// @param int      n         The count of bits to copy
+func copyBits(dst []uint64, dstOffset int, src []uint64, srcOffset int, n int) {
+	// copy the bits in chunks of up to one word
+	for n > 0 {
+		// get the count of bits that fit into the current destination word
+		chunk := wordSize - dstOffset%wordSize
+
+		// limit the chunk to the remaining bits
+		if chunk > n {
+			chunk = n
+		}
+
+		// read the chunk from the source and write it to the destination
+		writeWordBits(dst, dstOffset, readWordBits(src, srcOffset, chunk), chunk)
+
+		// continue with the next chunk
+		dstOffset += chunk
+		srcOffset += chunk
+		n -= chunk
+	}
+}
+
+// readWordBits reads n <= 64 bits starting at offset
+//
+// @param []uint64 words  The words to read from
+// @param int      offset The first bit to read
+// @param int      n      The count of bits to read
+//
+// @return uint64
+func readWordBits(words []uint64, offset, n int) uint64 {
+	// get the position of the first bit
+	index, shift := offset/wordSize, uint(offset%wordSize)
+
+	// read the bits from the first word
+	value := words[index] >> shift
+
+	// if the bits overlap into the next word...
+	if int(shift)+n > wordSize {
+		// ...read the remaining bits from it
+		value |= words[index+1] << (wordSize - shift)
+	}
+
+	// mask out the requested bits
+	return value & lowMask(n)
+}
+
+// writeWordBits writes the n lowest bits of value, which must not cross a
+// word boundary, to the given offset
+//
+// @param []uint64 words  The words to write to
+// @param int      offset The first bit to write
+// @param uint64   value  The bits to write
+// @param int      n      The count of bits to write
+func writeWordBits(words []uint64, offset int, value uint64, n int) {
+	// get the position of the first bit
+	index, shift := offset/wordSize, uint(offset%wordSize)
+
+	// create the mask of the bits to replace
+	mask := lowMask(n) << shift
+
+	// replace the bits
+	words[index] = words[index]&^mask | (value<<shift)&mask
+}
+
+// lowMask returns a word with the n lowest bits set
+//
+// @param int n The count of bits
+//
+// @return uint64
+func lowMask(n int) uint64 {
+	if n >= wordSize {
+		return ^uint64(0)
+	}
+
+	return uint64(1)<<uint(n) - 1
+}
+
+// bigToWords writes the bits of x into the words
+//
+// @param *big.Int x     The number to convert
+// @param []uint64 words The words to write to
+func bigToWords(x *big.Int, words []uint64) {
+	// clear the words
+	clearWords(words)
+
+	// copy the bits of x word by word
+	for i, word := range x.Bits() {
+		// big.Word is 32 or 64 bits wide depending on the platform
+		pos := i * bits.UintSize
+
+		// write the word into the destination words
+		words[pos/wordSize] |= uint64(word) << uint(pos%wordSize)
+	}
+}
+
+// wordsToBig converts the words into a big.Int
+//
+// @param []uint64 words The words to convert
+//
+// @return *big.Int
+func wordsToBig(words []uint64) *big.Int {
+	// initialize the big.Int words
+	bigWords := make([]big.Word, 0, len(words)*wordSize/bits.UintSize)
+
+	// split the words into big.Words
+	for _, word := range words {
+		for shift := 0; shift < wordSize; shift += bits.UintSize {
+			bigWords = append(bigWords, big.Word(word>>uint(shift)))
+		}
+	}
+
+	// create the big.Int from the words
+	return new(big.Int).SetBits(bigWords)
+}
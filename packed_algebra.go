@@ -0,0 +1,79 @@
+package gomatrix
+
+import (
+	"math/bits"
+)
+
+// AddMatrix adds two matrices
+//
+// This function adds a matrix to the matrix object. The result will be saved
+// in the object, whose AddMatrix method was called.
+//
+// @param *PackedF2 m The matrix to add
+//
+// @return *PackedF2|nil
+func (p *PackedF2) AddMatrix(m *PackedF2) *PackedF2 {
+	// if the size is not equal...
+	if p.N != m.N || p.M != m.M {
+		// ...return an error
+		return nil
+	}
+
+	// iterate through the rows
+	for i, row := range p.Rows {
+		// xor each row with the relating row of the second matrix
+		xorWords(row, m.Rows[i])
+	}
+
+	// return the matrix
+	return p
+}
+
+// MulMatrix multiplies matrix p with matrix m
+//
+// This functions multiplies matrix pxm. M could be a Nx1 matrix for a vector.
+// If the matrices cannot be multiplied, nil is returned and p is not
+// modified. If the multiplication was successful, the result is stored
+// in p and returned.
+//
+// Each row of the result is the sum of the rows of m that are selected by the
+// set bits in the relating row of p, so no columns have to be extracted.
+//
+// @param *PackedF2 m The matrix that is used for the multiplication
+//
+// @return *PackedF2
+func (p *PackedF2) MulMatrix(m *PackedF2) *PackedF2 {
+	// if the dimensions do not fit for a multiplication...
+	if p.M != m.N {
+		// ...return an error
+		return nil
+	}
+
+	// create the result matrix
+	result := NewPackedF2(p.N, m.M)
+
+	// iterate through the rows of p
+	for i, row := range p.Rows {
+		// iterate through the set bits of the row
+		for w, word := range row {
+			for word != 0 {
+				// get the index of the lowest set bit
+				k := w*wordSize + bits.TrailingZeros64(word)
+
+				// add the selected row of m to the result
+				xorWords(result.Rows[i], m.Rows[k])
+
+				// remove the lowest set bit
+				word &= word - 1
+			}
+		}
+	}
+
+	// save the result matrix in p
+	p.N = result.N
+	p.M = result.M
+	p.Rows = result.Rows
+
+	// return the result
+	return result
+}
@@ -0,0 +1,82 @@
+package gomatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackedAddMatrix(t *testing.T) {
+	tests := []struct {
+		description string
+		matrixA     *F2
+		matrixB     *F2
+		expectedNil bool
+	}{
+		{
+			description: "success",
+			matrixA:     randomF2(5, 130, 1),
+			matrixB:     randomF2(5, 130, 2),
+		},
+		{
+			description: "invalid addition",
+			matrixA:     randomF2(2, 2, 1),
+			matrixB:     randomF2(2, 3, 2),
+			expectedNil: true,
+		},
+	}
+
+	for _, test := range tests {
+		result := test.matrixA.ToPacked().AddMatrix(test.matrixB.ToPacked())
+
+		assert.Equalf(t, test.expectedNil, result == nil, test.description)
+
+		if result == nil {
+			continue
+		}
+
+		assert.Truef(t, test.matrixA.AddMatrix(test.matrixB).IsEqual(result.ToF2()), test.description)
+	}
+}
+
+func TestPackedMulMatrix(t *testing.T) {
+	tests := []struct {
+		description string
+		matrixA     *F2
+		matrixB     *F2
+		expectedNil bool
+	}{
+		{
+			description: "square matrices",
+			matrixA:     randomF2(8, 8, 1),
+			matrixB:     randomF2(8, 8, 2),
+		},
+		{
+			description: "non square matrices",
+			matrixA:     randomF2(7, 100, 3),
+			matrixB:     randomF2(100, 70, 4),
+		},
+		{
+			description: "invalid multiplication",
+			matrixA:     randomF2(2, 4, 1),
+			matrixB:     randomF2(2, 3, 2),
+			expectedNil: true,
+		},
+	}
+
+	for _, test := range tests {
+		packed := test.matrixA.ToPacked()
+		result := packed.MulMatrix(test.matrixB.ToPacked())
+
+		assert.Equalf(t, test.expectedNil, result == nil, test.description)
+
+		if result == nil {
+			continue
+		}
+
+		test.matrixA.MulMatrix(test.matrixB)
+
+		assert.Truef(t, test.matrixA.IsEqual(result.ToF2()), test.description)
+		assert.Truef(t, test.matrixA.IsEqual(packed.ToF2()), test.description)
+	}
+}
@@ -0,0 +1,321 @@
+package gomatrix
+
+// GaussianElimination converts the matrix to the reduced row echelon form
+//
+// This function applies the gaussian elimination to the matrix in order to
+// create the reduced row echelon form. For a square matrix with full rank,
+// this is the identity matrix.
+func (p *PackedF2) GaussianElimination() {
+	p.reduce(0, 0, p.N, p.M)
+}
+
+// Rank returns the rank of the matrix without modifying it
+//
+// @return int
+func (p *PackedF2) Rank() int {
+	// create a copy of the matrix
+	clone := NewPackedF2(p.N, p.M)
+	for i, row := range p.Rows {
+		copy(clone.Rows[i], row)
+	}
+
+	// reduce the copy and count the pivot columns
+	return len(clone.reduce(0, 0, p.N, p.M))
+}
+
+// reduce converts a part of the matrix to the reduced row echelon form
+//
+// This function works like F2.reduce. The pivot bits are searched in the
+// columns startCol to stopCol-1 of the rows startRow to stopRow-1.
+//
+// @param int startRow The first row to process
+// @param int startCol The first column to search for pivot bits
+// @param int stopRow  The row after the last row to process
+// @param int stopCol  The column after the last column to search
+//
+// @return []int The columns that contain a pivot bit
+func (p *PackedF2) reduce(startRow, startCol, stopRow, stopCol int) []int {
+	// initialize the pivot columns
+	pivotCols := []int{}
+
+	// initialize the row for the next pivot bit
+	pivotRow := startRow
+
+	// iterate through the columns until all rows contain a pivot bit
+	for col := startCol; col < stopCol && pivotRow < stopRow; col++ {
+		// initialize the index of the row with the pivot bit
+		found := -1
+
+		// search a row with a 1 in the current column
+		for rowCounter := pivotRow; rowCounter < stopRow; rowCounter++ {
+			if wordBit(p.Rows[rowCounter], col) != 0 {
+				found = rowCounter
+				break
+			}
+		}
+
+		// if there is no pivot bit in the column...
+		if found < 0 {
+			// ...it is a free column
+			continue
+		}
+
+		// swap the row with the pivot bit into place
+		p.Rows[pivotRow], p.Rows[found] = p.Rows[found], p.Rows[pivotRow]
+
+		// eliminate the pivot bit from all other rows
+		for rowCounter := startRow; rowCounter < stopRow; rowCounter++ {
+			// skip the pivot row and rows with a 0 in the current column
+			if rowCounter == pivotRow || wordBit(p.Rows[rowCounter], col) == 0 {
+				continue
+			}
+
+			// subtract the pivot row
+			xorWords(p.Rows[rowCounter], p.Rows[pivotRow])
+		}
+
+		// save the pivot column and continue with the next row
+		pivotCols = append(pivotCols, col)
+		pivotRow++
+	}
+
+	return pivotCols
+}
+
+// PartialGaussianElimination performs a gaussian elimination on a part of the matrix
+//
+// The stop boundaries are included. If the range is not inside of the
+// matrix, ErrIndexOutOfRange is returned and the matrix is not modified.
+//
+// @param int startRow The first row to process
+// @param int startCol The first column to process
+// @param int stopRow  The last row to process
+// @param int stopCol  The last column to process
+//
+// @return error
+func (p *PackedF2) PartialGaussianElimination(startRow, startCol, stopRow, stopCol int) error {
+	// verify the range
+	if err := checkGaussRange(p.N, p.M, startRow, startCol, stopRow, stopCol); err != nil {
+		return err
+	}
+
+	// iterate through all possible pivot bits
+	for pivotBit := startCol; pivotBit <= stopCol; pivotBit++ {
+		// get the row that should contain the pivot bit
+		pivotRow := startRow + pivotBit - startCol
+
+		// iterate through the rows
+		for rowCounter := pivotRow; rowCounter <= stopRow; rowCounter++ {
+			// if the pivotbit of this row is 0...
+			if wordBit(p.Rows[rowCounter], pivotBit) == 0 {
+				// ...check the next row
+				continue
+			}
+
+			// if the row with a valid pivot bit is not the first row...
+			if pivotRow != rowCounter {
+				// ...swap it with first one
+				p.SwapRows(pivotRow, rowCounter)
+			}
+
+			// iterate through all other rows except the first one
+			for rr := pivotRow + 1; rr <= stopRow; rr++ {
+				if wordBit(p.Rows[rr], pivotBit) == 0 {
+					continue
+				}
+
+				// subtract the 1 from all other rows with the pivotBit
+				xorWords(p.Rows[rr], p.Rows[pivotRow])
+			}
+
+			break
+		}
+	}
+
+	// do the same thing backwards to get the identity matrix
+	p.partialDiagonalize(startRow, startCol, stopRow, stopCol, nil)
+
+	return nil
+}
+
+// partialDiagonalize removes the 1 entries above and below the pivot bits
+// of the submatrix. All row operations are applied to gaussMatrix as well, if
+// it is not nil.
+func (p *PackedF2) partialDiagonalize(startRow, startCol, stopRow, stopCol int, gaussMatrix *PackedF2) *PackedF2 {
+	// iterate backwards through the pivot bits
+	for pivotBit := stopCol; pivotBit >= startCol; pivotBit-- {
+		// get the row that contains the pivot bit
+		pivotRow := startRow + pivotBit - startCol
+
+		// choose each row from the top row to the one with the pivot bit
+		for rowCounter := startRow; rowCounter < stopRow; rowCounter++ {
+			// prevent xor with the row itself
+			if rowCounter == pivotRow {
+				continue
+			}
+
+			// if the bit in the same position at the other row is 0...
+			if wordBit(p.Rows[rowCounter], pivotBit) == 0 {
+				// ...continue to the next row
+				continue
+			}
+
+			// eliminate the 1
+			xorWords(p.Rows[rowCounter], p.Rows[pivotRow])
+
+			if gaussMatrix == nil {
+				continue
+			}
+
+			// eliminate the 1
+			xorWords(gaussMatrix.Rows[rowCounter], gaussMatrix.Rows[pivotRow])
+		}
+	}
+
+	return gaussMatrix
+}
+
+// PartialGaussianWithLinearChecking performs a partial gaussian elimination
+//
+// This function performs a gaussian elimination on the matrix and calls the
+// check callback after each iteration in order to verify that linear
+// dependencies in the code could be resolved easily. The function returns
+// the permutation matrix in addition to the error. For the linearCheck
+// callback take a look at the resolver package.
+func (p *PackedF2) PartialGaussianWithLinearChecking(
+	startRow int,
+	startCol int,
+	stopRow int,
+	stopCol int,
+	linearCheck func(*PackedF2, *PackedF2, *PackedF2, int, int, int, int, int) (*PackedF2, *PackedF2, error),
+) (*PackedF2, *PackedF2, error) {
+	// verify the range
+	if err := checkGaussRange(p.N, p.M, startRow, startCol, stopRow, stopCol); err != nil {
+		return nil, nil, err
+	}
+
+	// initialize the permutation matrix
+	gaussMatrix := NewPackedF2(p.N, p.N).SetToIdentity()
+	permutationMatrix := NewPackedF2(p.M, p.M).SetToIdentity()
+
+	// initialize the error vector
+	var err error
+
+	// iterate through all possible pivot bits
+	for pivotBit := startCol; pivotBit <= stopCol; pivotBit++ {
+		// intialize the pivotbit indicator
+		foundPivotBit := false
+
+		// get the row that should contain the pivot bit
+		pivotRow := startRow + pivotBit - startCol
+
+		// iterate through the rows
+		for rowCounter := pivotRow; rowCounter <= stopRow; rowCounter++ {
+			// if the pivotbit of this row is 0...
+			if wordBit(p.Rows[rowCounter], pivotBit) == 0 {
+				// ...check the next row
+				continue
+			}
+
+			// if the row with a valid pivot bit is not the first row...
+			if pivotRow != rowCounter {
+				// ...swap it with first one
+				p.SwapRows(pivotRow, rowCounter)
+				gaussMatrix.SwapRows(pivotRow, rowCounter)
+			}
+
+			// iterate through all other rows except the first one
+			for rr := pivotRow + 1; rr <= stopRow; rr++ {
+				if wordBit(p.Rows[rr], pivotBit) == 0 {
+					continue
+				}
+
+				// subtract the 1 from all other rows with the pivotBit
+				xorWords(p.Rows[rr], p.Rows[pivotRow])
+				xorWords(gaussMatrix.Rows[rr], gaussMatrix.Rows[pivotRow])
+			}
+
+			// indicate the pivotbit is found
+			foundPivotBit = true
+
+			// break out of the loop
+			break
+		}
+
+		// if a pivot bit was found...
+		if foundPivotBit {
+			// ...skip to the next row
+			continue
+		}
+
+		// detect linear dependencies and try to resolve them
+		gaussMatrix, permutationMatrix, err = linearCheck(
+			p,
+			gaussMatrix,
+			permutationMatrix,
+			startRow,
+			startCol,
+			stopRow,
+			stopCol,
+			pivotBit,
+		)
+
+		// check the error
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// process the same row again
+		pivotBit--
+	}
+
+	// do the same thing backwards to get the identity matrix
+	gaussMatrix = p.partialDiagonalize(startRow, startCol, stopRow, stopCol, gaussMatrix)
+
+	return gaussMatrix, permutationMatrix, nil
+}
+
+// CheckGaussian checks if the given range in the matrix is the identity matrix
+//
+// If the range is not inside of the matrix, the check fails.
+//
+// @param int startRow The row where the check starts
+// @param int startCol The column where the check starts
+// @param int n        The size of the submatrix to check
+//
+// @return bool
+func (p *PackedF2) CheckGaussian(startRow, startCol, n int) bool {
+	// verify the range
+	if startRow < 0 || startCol < 0 || n < 0 || startRow+n > p.N || startCol+n > p.M {
+		return false
+	}
+
+	// iterate through the rows
+	for i := 0; i < n; i++ {
+		// get the row
+		row := p.Rows[startRow+i]
+
+		// check the bits of the submatrix in chunks of one word
+		for offset := 0; offset < n; offset += wordSize {
+			// get the count of bits to check
+			chunk := n - offset
+			if chunk > wordSize {
+				chunk = wordSize
+			}
+
+			// calculate the expected bits if it is in echelon form
+			expectedBits := uint64(0)
+			if i >= offset && i < offset+chunk {
+				expectedBits = uint64(1) << uint(i-offset)
+			}
+
+			// if the bits differ...
+			if readWordBits(row, startCol+offset, chunk) != expectedBits {
+				// ...the check failed
+				return false
+			}
+		}
+	}
+
+	return true
+}
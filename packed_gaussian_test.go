@@ -0,0 +1,181 @@
+package gomatrix
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackedGaussianElimination(t *testing.T) {
+	tests := []struct {
+		description    string
+		matrixA        *PackedF2
+		expectedMatrix *PackedF2
+	}{
+		{
+			matrixA:        NewPackedF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(2)}),
+			expectedMatrix: NewPackedF2(3, 3).Set([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(4)}),
+		},
+		{
+			matrixA:        NewPackedF2(3, 3).Set([]*big.Int{big.NewInt(2), big.NewInt(5), big.NewInt(3)}),
+			expectedMatrix: NewPackedF2(3, 3).Set([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(4)}),
+		},
+	}
+
+	for _, test := range tests {
+		test.matrixA.GaussianElimination()
+
+		assert.True(t, test.matrixA.IsEqual(test.expectedMatrix))
+	}
+}
+
+func TestPackedPartialGaussianElimination(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *F2
+		startRow    int
+		startCol    int
+		stopRow     int
+		stopCol     int
+	}{
+		{
+			description: "4x4 matrix",
+			matrix:      NewF2(4, 4).Set([]*big.Int{big.NewInt(10), big.NewInt(7), big.NewInt(4), big.NewInt(1)}),
+			startRow:    0,
+			stopRow:     2,
+			startCol:    1,
+			stopCol:     3,
+		},
+		{
+			description: "random matrix",
+			matrix:      randomF2(90, 150, 9),
+			startRow:    10,
+			stopRow:     79,
+			startCol:    60,
+			stopCol:     129,
+		},
+	}
+
+	for _, test := range tests {
+		packed := test.matrix.ToPacked()
+
+		test.matrix.PartialGaussianElimination(test.startRow, test.startCol, test.stopRow, test.stopCol)
+		packed.PartialGaussianElimination(test.startRow, test.startCol, test.stopRow, test.stopCol)
+
+		assert.Truef(t, test.matrix.IsEqual(packed.ToF2()), test.description)
+	}
+}
+
+func TestPackedPartialGaussianWithLinearChecking(t *testing.T) {
+	tests := []struct {
+		description    string
+		matrix         *PackedF2
+		expectedResult *PackedF2
+		expectedError  bool
+	}{
+		{
+			description: "4x4 without dependencies",
+			matrix: NewPackedF2(4, 4).Set([]*big.Int{
+				big.NewInt(10),
+				big.NewInt(7),
+				big.NewInt(4),
+				big.NewInt(1),
+			}),
+			expectedResult: NewPackedF2(4, 4).Set([]*big.Int{
+				big.NewInt(3),
+				big.NewInt(4),
+				big.NewInt(9),
+				big.NewInt(1),
+			}),
+		},
+		{
+			description: "4x4 with error",
+			matrix: NewPackedF2(4, 4).Set([]*big.Int{
+				big.NewInt(10),
+				big.NewInt(13),
+				big.NewInt(12),
+				big.NewInt(14),
+			}),
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		savedMatrix := test.matrix.ToF2()
+
+		gaussMatrix, _, err := test.matrix.PartialGaussianWithLinearChecking(
+			0,
+			1,
+			2,
+			3,
+			func(f, gaussMatrix, permMatrix *PackedF2, startRow, startCol, stopRow, stopCol, pivotBit int) (*PackedF2, *PackedF2, error) {
+				return nil, nil, fmt.Errorf("testfoo")
+			},
+		)
+
+		assert.Equalf(t, test.expectedError, err != nil, test.description)
+
+		if err != nil {
+			continue
+		}
+
+		assert.Truef(t, test.expectedResult.IsEqual(test.matrix), test.description)
+
+		// verify that the transformation matrix creates the processed matrix
+		assert.Truef(t, gaussMatrix.ToF2().MulMatrix(savedMatrix).IsEqual(test.matrix.ToF2()), test.description)
+	}
+}
+
+func TestPackedCheckGaussian(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *F2
+		startRow    int
+		startCol    int
+		n           int
+	}{
+		{
+			description: "identity matrix",
+			matrix:      NewF2(3, 3).SetToIdentity(),
+			startRow:    0,
+			startCol:    0,
+			n:           3,
+		},
+		{
+			description: "3x3 matrix",
+			matrix:      NewF2(3, 3).Set([]*big.Int{big.NewInt(3), big.NewInt(2), big.NewInt(4)}),
+			startRow:    0,
+			startCol:    0,
+			n:           3,
+		},
+		{
+			description: "large identity",
+			matrix:      NewF2(100, 150).SetToIdentity(),
+			startRow:    0,
+			startCol:    0,
+			n:           100,
+		},
+		{
+			description: "large shifted identity",
+			matrix:      NewF2(100, 170).SetToIdentity().T().GetSubMatrix(0, 10, 170, 90).T(),
+			startRow:    0,
+			startCol:    10,
+			n:           80,
+		},
+		{
+			description: "large random matrix",
+			matrix:      randomF2(100, 170, 10),
+			startRow:    10,
+			startCol:    70,
+			n:           80,
+		},
+	}
+
+	for _, test := range tests {
+		result := test.matrix.ToPacked().CheckGaussian(test.startRow, test.startCol, test.n)
+
+		assert.Equalf(t, test.matrix.CheckGaussian(test.startRow, test.startCol, test.n), result, test.description)
+	}
+}
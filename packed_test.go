@@ -0,0 +1,287 @@
+package gomatrix
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// randomF2 creates a reproducible random matrix for the tests
+func randomF2(n, m int, seed int64) *F2 {
+	rng := rand.New(rand.NewSource(seed))
+
+	matrix := NewF2(n, m)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			matrix.Rows[i].SetBit(matrix.Rows[i], j, uint(rng.Intn(2)))
+		}
+	}
+
+	return matrix
+}
+
+func TestPackedConversion(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *F2
+	}{
+		{
+			description: "3x3 matrix",
+			matrix:      NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(2)}),
+		},
+		{
+			description: "matrix with trailing zero columns",
+			matrix:      NewF2(2, 130).Set([]*big.Int{big.NewInt(1), big.NewInt(0)}),
+		},
+		{
+			description: "large random matrix",
+			matrix:      randomF2(17, 200, 1),
+		},
+		{
+			description: "empty matrix",
+			matrix:      NewF2(0, 5),
+		},
+	}
+
+	for _, test := range tests {
+		packed := test.matrix.ToPacked()
+
+		assert.Equalf(t, test.matrix.N, packed.N, test.description)
+		assert.Equalf(t, test.matrix.M, packed.M, test.description)
+		assert.Truef(t, test.matrix.IsEqual(packed.ToF2()), test.description)
+
+		for i, row := range packed.BigRows() {
+			assert.Zerof(t, row.Cmp(test.matrix.Rows[i]), test.description)
+		}
+	}
+}
+
+func TestPackedSet(t *testing.T) {
+	tests := []struct {
+		description string
+		n           int
+		m           int
+		data        []*big.Int
+		expectedNil bool
+	}{
+		{
+			description: "2x2 matrix",
+			n:           2,
+			m:           2,
+			data:        []*big.Int{big.NewInt(1), big.NewInt(2)},
+			expectedNil: false,
+		},
+		{
+			description: "wrong row count",
+			n:           3,
+			m:           2,
+			data:        []*big.Int{big.NewInt(1), big.NewInt(2)},
+			expectedNil: true,
+		},
+		{
+			description: "wrong col count",
+			n:           2,
+			m:           2,
+			data:        []*big.Int{big.NewInt(5), big.NewInt(2)},
+			expectedNil: true,
+		},
+		{
+			description: "negative row",
+			n:           2,
+			m:           2,
+			data:        []*big.Int{big.NewInt(-1), big.NewInt(2)},
+			expectedNil: true,
+		},
+	}
+
+	for _, test := range tests {
+		result := NewPackedF2(test.n, test.m).Set(test.data)
+
+		assert.Equalf(t, test.expectedNil, result == nil, test.description)
+	}
+}
+
+func TestPackedAt(t *testing.T) {
+	tests := []struct {
+		description    string
+		matrix         *PackedF2
+		i              int
+		j              int
+		expectedError  bool
+		expectedResult int
+	}{
+		{
+			description:    "set bit",
+			matrix:         NewPackedF2(2, 2).Set([]*big.Int{big.NewInt(2), big.NewInt(1)}),
+			i:              0,
+			j:              1,
+			expectedResult: 1,
+		},
+		{
+			description:    "unset bit",
+			matrix:         NewPackedF2(2, 2).Set([]*big.Int{big.NewInt(2), big.NewInt(1)}),
+			i:              1,
+			j:              1,
+			expectedResult: 0,
+		},
+		{
+			description:   "invalid j",
+			matrix:        NewPackedF2(2, 2).Set([]*big.Int{big.NewInt(2), big.NewInt(1)}),
+			i:             1,
+			j:             3,
+			expectedError: true,
+		},
+		{
+			description:   "negative i",
+			matrix:        NewPackedF2(2, 2).Set([]*big.Int{big.NewInt(2), big.NewInt(1)}),
+			i:             -1,
+			j:             0,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := test.matrix.At(test.i, test.j)
+
+		assert.Equalf(t, test.expectedError, err != nil, test.description)
+
+		if err != nil {
+			continue
+		}
+
+		assert.Equalf(t, test.expectedResult, result, test.description)
+	}
+}
+
+func TestPackedT(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *F2
+	}{
+		{
+			description: "3x2 matrix",
+			matrix:      NewF2(3, 2).Set([]*big.Int{big.NewInt(3), big.NewInt(1), big.NewInt(0)}),
+		},
+		{
+			description: "wide random matrix",
+			matrix:      randomF2(5, 150, 2),
+		},
+	}
+
+	for _, test := range tests {
+		packed := test.matrix.ToPacked().T()
+
+		assert.Truef(t, test.matrix.T().IsEqual(packed.ToF2()), test.description)
+	}
+}
+
+func TestPackedPartialT(t *testing.T) {
+	matrix := NewPackedF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(6), big.NewInt(4)})
+	expected := NewPackedF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(2), big.NewInt(6)})
+
+	assert.Nil(t, matrix.PartialT(1, 1, 2))
+	assert.True(t, matrix.IsEqual(expected))
+	assert.NotNil(t, matrix.PartialT(1, 1, 3))
+}
+
+func TestPackedSwapRowsAndCols(t *testing.T) {
+	matrix := randomF2(6, 100, 3)
+	packed := matrix.ToPacked()
+
+	assert.Nil(t, matrix.SwapRows(1, 4))
+	assert.Nil(t, packed.SwapRows(1, 4))
+	assert.Nil(t, matrix.SwapCols(3, 97))
+	assert.Nil(t, packed.SwapCols(3, 97))
+	assert.True(t, matrix.IsEqual(packed.ToF2()))
+
+	assert.NotNil(t, packed.SwapRows(6, 0))
+	assert.NotNil(t, packed.SwapCols(0, 100))
+	assert.NotNil(t, packed.XorRow(-1, 0))
+}
+
+func TestPackedPermuteCols(t *testing.T) {
+	matrix := randomF2(4, 70, 4)
+	packed := matrix.ToPacked()
+
+	permMat := packed.PermuteCols()
+
+	assert.True(t, matrix.MulMatrix(permMat.ToF2()).IsEqual(packed.ToF2()))
+}
+
+func TestPackedGetCol(t *testing.T) {
+	matrix := randomF2(80, 5, 5)
+	packed := matrix.ToPacked()
+
+	for i := 0; i < matrix.M; i++ {
+		assert.Zero(t, wordsToBig(packed.GetCol(i)).Cmp(matrix.GetCol(i)))
+	}
+
+	assert.Nil(t, packed.GetCol(5))
+}
+
+func TestPackedSubMatrix(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *F2
+		startRow    int
+		startCol    int
+		stopRow     int
+		stopCol     int
+	}{
+		{
+			description: "small submatrix",
+			matrix:      randomF2(3, 4, 6),
+			startRow:    0,
+			startCol:    1,
+			stopRow:     2,
+			stopCol:     4,
+		},
+		{
+			description: "submatrix crossing word boundaries",
+			matrix:      randomF2(10, 200, 7),
+			startRow:    2,
+			startCol:    60,
+			stopRow:     9,
+			stopCol:     190,
+		},
+	}
+
+	for _, test := range tests {
+		packed := test.matrix.ToPacked()
+
+		expected := test.matrix.GetSubMatrix(test.startRow, test.startCol, test.stopRow, test.stopCol)
+		result := packed.GetSubMatrix(test.startRow, test.startCol, test.stopRow, test.stopCol)
+
+		assert.Truef(t, expected.IsEqual(result.ToF2()), test.description)
+
+		sub := randomF2(expected.N, expected.M, 8)
+
+		_, err := test.matrix.SetSubMatrix(sub, test.startRow, test.startCol)
+		assert.Nilf(t, err, test.description)
+
+		_, err = packed.SetSubMatrix(sub.ToPacked(), test.startRow, test.startCol)
+		assert.Nilf(t, err, test.description)
+
+		assert.Truef(t, test.matrix.IsEqual(packed.ToF2()), test.description)
+	}
+
+	_, err := NewPackedF2(3, 3).SetSubMatrix(NewPackedF2(2, 4), 1, 1)
+	assert.NotNil(t, err)
+
+	// the boundaries are verified like for F2
+	boundaries := [][4]int{
+		{-1, 0, 2, 2},
+		{0, 0, 4, 3},
+		{0, 0, 3, 4},
+		{2, 0, 1, 3},
+	}
+
+	for _, b := range boundaries {
+		_, err := NewPackedF2(3, 3).GetSubMatrixChecked(b[0], b[1], b[2], b[3])
+		assert.Equal(t, ErrIndexOutOfRange, err)
+		assert.Nil(t, NewPackedF2(3, 3).GetSubMatrix(b[0], b[1], b[2], b[3]))
+	}
+}
@@ -0,0 +1,88 @@
+package gomatrix
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// minRowsPerWorker is the minimum count of rows that is processed by one
+// goroutine. Smaller matrices are processed without additional goroutines.
+const minRowsPerWorker = 64
+
+// workers is the count of goroutines used for the row operations
+var workers = int32(runtime.NumCPU())
+
+// SetWorkers sets the count of goroutines that are used for the row
+// operations of MulMatrix, AddMatrix and the gaussian eliminations
+//
+// The rows are split into blocks, which are processed concurrently. The
+// results do not depend on the count of workers. A count below 1 is treated
+// as 1, which processes all rows on the calling goroutine. The default is the
+// count of CPUs.
+//
+// @param int n The count of goroutines
+func SetWorkers(n int) {
+	// use at least one worker
+	if n < 1 {
+		n = 1
+	}
+
+	atomic.StoreInt32(&workers, int32(n))
+}
+
+// Workers returns the count of goroutines used for the row operations
+//
+// @return int
+func Workers() int {
+	return int(atomic.LoadInt32(&workers))
+}
+
+// parallelRows calls fn for blocks of the rows 0 to n-1 concurrently
+//
+// fn is called with the first row and the row after the last row of each
+// block. The blocks do not overlap, so fn may modify the rows of its block.
+// The function returns after all blocks are processed.
+//
+// @param int                      n  The count of rows
+// @param func(start, stop int) fn The function that processes a block
+func parallelRows(n int, fn func(start, stop int)) {
+	// limit the count of workers by the count of rows
+	count := Workers()
+	if count > n/minRowsPerWorker {
+		count = n / minRowsPerWorker
+	}
+
+	// if there is only one block...
+	if count <= 1 {
+		// ...process it directly
+		fn(0, n)
+		return
+	}
+
+	// get the size of each block
+	size := (n + count - 1) / count
+
+	// initialize the wait group for the workers
+	var wg sync.WaitGroup
+
+	// start a worker for each block
+	for start := 0; start < n; start += size {
+		// get the end of the block
+		stop := start + size
+		if stop > n {
+			stop = n
+		}
+
+		wg.Add(1)
+
+		go func(start, stop int) {
+			defer wg.Done()
+
+			fn(start, stop)
+		}(start, stop)
+	}
+
+	// wait for all workers
+	wg.Wait()
+}
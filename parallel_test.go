@@ -0,0 +1,93 @@
+package gomatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetWorkers(t *testing.T) {
+	defer SetWorkers(Workers())
+
+	SetWorkers(3)
+	assert.Equal(t, 3, Workers())
+
+	SetWorkers(0)
+	assert.Equal(t, 1, Workers())
+}
+
+func TestParallelRows(t *testing.T) {
+	defer SetWorkers(Workers())
+
+	SetWorkers(4)
+
+	for _, n := range []int{0, 1, 63, 128, 300, 1000} {
+		visited := make([]int, n)
+
+		parallelRows(n, func(start, stop int) {
+			for i := start; i < stop; i++ {
+				visited[i]++
+			}
+		})
+
+		for i := range visited {
+			assert.Equalf(t, 1, visited[i], "row %d of %d", i, n)
+		}
+	}
+}
+
+func TestParallelOperations(t *testing.T) {
+	defer SetWorkers(Workers())
+
+	operations := []struct {
+		description string
+		operation   func() *F2
+	}{
+		{
+			description: "AddMatrix",
+			operation: func() *F2 {
+				return randomF2(300, 200, 1).AddMatrix(randomF2(300, 200, 2))
+			},
+		},
+		{
+			description: "MulMatrix",
+			operation: func() *F2 {
+				return randomF2(300, 200, 3).MulMatrix(randomF2(200, 150, 4))
+			},
+		},
+		{
+			description: "naive multiplication",
+			operation: func() *F2 {
+				return randomF2(200, 20, 5).mulNaive(randomF2(20, 30, 6))
+			},
+		},
+		{
+			description: "GaussianElimination",
+			operation: func() *F2 {
+				matrix := randomF2(300, 250, 7)
+				matrix.GaussianElimination()
+
+				return matrix
+			},
+		},
+		{
+			description: "PartialGaussianElimination",
+			operation: func() *F2 {
+				matrix := randomF2(300, 400, 8)
+				matrix.PartialGaussianElimination(10, 20, 289, 299)
+
+				return matrix
+			},
+		},
+	}
+
+	for _, operation := range operations {
+		SetWorkers(1)
+		expected := operation.operation()
+
+		SetWorkers(8)
+		result := operation.operation()
+
+		assert.Truef(t, expected.IsEqual(result), operation.description)
+	}
+}
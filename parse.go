@@ -0,0 +1,471 @@
+package gomatrix
+
+import (
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// latexBegin and latexEnd enclose the rows of a matrix in latex code
+const (
+	latexBegin = "\\begin{bmatrix}"
+	latexEnd   = "\\end{bmatrix}"
+)
+
+// token is a single value of a row with its column in the line
+type token struct {
+	text string
+	col  int
+}
+
+// ParseSlim reads a matrix in the layout of WriteSlim
+//
+// Each line contains one row as characters '0' and '1'. Trailing whitespaces
+// are ignored. Malformed input is reported as *ParseError.
+//
+// @param io.Reader r The reader to read the matrix from
+//
+// @return *F2, error
+func ParseSlim(r io.Reader) (*F2, error) {
+	// read the lines
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRows(lines, 0, slimTokens)
+}
+
+// ParsePretty reads a matrix in the layout of WritePretty
+//
+// Each line contains one row with the values separated by whitespaces.
+// Malformed input is reported as *ParseError.
+//
+// @param io.Reader r The reader to read the matrix from
+//
+// @return *F2, error
+func ParsePretty(r io.Reader) (*F2, error) {
+	// read the lines
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRows(lines, 0, prettyTokens)
+}
+
+// ParseCSV reads a matrix in the layout of WriteCSV
+//
+// Each line contains one row with the values separated by commas.
+// Whitespaces around the values are ignored. Malformed input is reported as
+// *ParseError.
+//
+// @param io.Reader r The reader to read the matrix from
+//
+// @return *F2, error
+func ParseCSV(r io.Reader) (*F2, error) {
+	// read the lines
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRows(lines, 0, csvTokens)
+}
+
+// ParseLaTeX reads a matrix in the layout of WriteLaTex
+//
+// The rows need to be enclosed in a bmatrix environment, the values are
+// separated by '&' and each row except the last one ends with "\\".
+// Malformed input is reported as *ParseError.
+//
+// @param io.Reader r The reader to read the matrix from
+//
+// @return *F2, error
+func ParseLaTeX(r io.Reader) (*F2, error) {
+	// read the lines
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLaTeXLines(lines)
+}
+
+// Parse reads a matrix and detects the format from the content
+//
+// The format is chosen by the first line: "\begin{bmatrix}" selects
+// ParseLaTeX, dimensions like "2x3" select the format of MarshalText, a comma
+// selects ParseCSV, whitespaces between the values select ParsePretty and
+// everything else ParseSlim.
+//
+// @param io.Reader r The reader to read the matrix from
+//
+// @return *F2, error
+func Parse(r io.Reader) (*F2, error) {
+	// read the lines
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// no lines are an empty matrix in every format
+	if len(lines) == 0 {
+		return NewF2(0, 0), nil
+	}
+
+	// detect the format from the first line
+	first := strings.TrimSpace(lines[0])
+	switch {
+	case strings.HasPrefix(first, "\\begin"):
+		return parseLaTeXLines(lines)
+	case isDimensions(first):
+		return parseTextLines(lines)
+	case strings.Contains(first, ","):
+		return parseRows(lines, 0, csvTokens)
+	case strings.ContainsAny(first, " \t"):
+		return parseRows(lines, 0, prettyTokens)
+	default:
+		return parseRows(lines, 0, slimTokens)
+	}
+}
+
+// parseTextLines reads a matrix from the lines of MarshalText
+//
+// The count of rows is compared with the lines of the input before the
+// matrix is created. Since empty lines at the end of the input are removed,
+// matrices with rows but without columns cannot be read.
+//
+// @param []string lines The lines with the dimensions and the rows
+//
+// @return *F2, error
+func parseTextLines(lines []string) (*F2, error) {
+	// read the dimensions
+	n, m, err := parseDimensions(strings.TrimSpace(lines[0]))
+	if err != nil {
+		return nil, &ParseError{Line: 1, Col: 1, Msg: "invalid dimensions"}
+	}
+
+	// verify the count of rows
+	rows := lines[1:]
+	if len(rows) > n {
+		return nil, &ParseError{Line: n + 2, Col: 1, Msg: "unexpected row"}
+	}
+
+	if len(rows) < n {
+		return nil, endOfInput(lines)
+	}
+
+	// verify the count of columns
+	for i, row := range rows {
+		width := len(slimTokens(row))
+		if width == m {
+			continue
+		}
+
+		col := width + 1
+		if width > m {
+			col = m + 1
+		}
+
+		return nil, &ParseError{
+			Line: i + 2,
+			Col:  col,
+			Msg:  "row has a different count of values than the dimensions",
+		}
+	}
+
+	// read the values
+	result, err := parseRows(rows, 1, slimTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	// a matrix without rows keeps its count of columns
+	result.M = m
+
+	return result, nil
+}
+
+// parseLaTeXLines reads a matrix from the lines of latex code
+//
+// @param []string lines The lines of the latex code
+//
+// @return *F2, error
+func parseLaTeXLines(lines []string) (*F2, error) {
+	// the rows need to be enclosed in the environment
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != latexBegin {
+		return nil, &ParseError{Line: 1, Col: 1, Msg: "expected " + latexBegin}
+	}
+
+	last := len(lines) - 1
+	if last == 0 || strings.TrimSpace(lines[last]) != latexEnd {
+		return nil, &ParseError{Line: last + 1, Col: 1, Msg: "expected " + latexEnd}
+	}
+
+	// remove the line breaks at the end of the rows
+	rows := make([]string, last-1)
+	for i := range rows {
+		line := strings.TrimRight(lines[i+1], " \t")
+
+		// the line break is optional for the last row
+		if strings.HasSuffix(line, "\\\\") {
+			line = strings.TrimSuffix(line, "\\\\")
+		} else if i < len(rows)-1 {
+			return nil, &ParseError{Line: i + 2, Col: len(line) + 1, Msg: "expected \\\\"}
+		}
+
+		rows[i] = line
+	}
+
+	return parseRows(rows, 1, latexTokens)
+}
+
+// parseRows creates the matrix from the values in the lines
+//
+// @param []string             lines    The lines with the rows
+// @param int                  offset   The count of lines in front of lines
+// @param func(string) []token tokenize The function that splits the values
+//
+// @return *F2, error
+func parseRows(lines []string, offset int, tokenize func(string) []token) (*F2, error) {
+	// split the values of each row
+	rows := make([][]token, len(lines))
+	for i, line := range lines {
+		rows[i] = tokenize(line)
+	}
+
+	// the first row defines the count of columns
+	m := 0
+	if len(rows) > 0 {
+		m = len(rows[0])
+	}
+
+	// create the result matrix
+	result := NewF2(len(rows), m)
+
+	// iterate through the rows
+	for i, row := range rows {
+		// verify the count of values
+		if len(row) != m {
+			col := len(lines[i]) + 1
+			if len(row) > m {
+				col = row[m].col
+			}
+
+			return nil, &ParseError{
+				Line: offset + i + 1,
+				Col:  col,
+				Msg:  "row has a different count of values than the first row",
+			}
+		}
+
+		// set the values
+		for j, value := range row {
+			switch value.text {
+			case "0":
+			case "1":
+				result.Rows[i].SetBit(result.Rows[i], j, 1)
+			default:
+				return nil, &ParseError{
+					Line: offset + i + 1,
+					Col:  value.col,
+					Msg:  "invalid value \"" + value.text + "\"",
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// readLines reads all lines from the reader
+//
+// Lines may end with "\r\n" and empty lines at the end are removed.
+//
+// @param io.Reader r The reader to read the lines from
+//
+// @return []string, error
+func readLines(r io.Reader) ([]string, error) {
+	// read the complete content
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// remove the empty lines at the end
+	content := strings.Replace(string(data), "\r\n", "\n", -1)
+	content = strings.TrimRight(content, "\n")
+	if content == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(content, "\n"), nil
+}
+
+// isDimensions checks if the line contains dimensions like "2x3"
+//
+// @param string line The line to check
+//
+// @return bool
+func isDimensions(line string) bool {
+	_, _, err := parseDimensions(line)
+
+	return err == nil
+}
+
+// slimTokens splits the line into single characters
+//
+// @param string line The line to split
+//
+// @return []token
+func slimTokens(line string) []token {
+	// ignore trailing whitespaces
+	line = strings.TrimRight(line, " \t")
+
+	// each character is a value
+	tokens := make([]token, len(line))
+	for i := range tokens {
+		tokens[i] = token{text: line[i : i+1], col: i + 1}
+	}
+
+	return tokens
+}
+
+// prettyTokens splits the line at whitespaces
+//
+// @param string line The line to split
+//
+// @return []token
+func prettyTokens(line string) []token {
+	// initialize the tokens
+	tokens := []token{}
+
+	// iterate through the characters
+	for i := 0; i < len(line); {
+		// skip the whitespaces
+		if line[i] == ' ' || line[i] == '\t' {
+			i++
+			continue
+		}
+
+		// read the value up to the next whitespace
+		start := i
+		for i < len(line) && line[i] != ' ' && line[i] != '\t' {
+			i++
+		}
+
+		tokens = append(tokens, token{text: line[start:i], col: start + 1})
+	}
+
+	return tokens
+}
+
+// csvTokens splits the line at commas
+//
+// @param string line The line to split
+//
+// @return []token
+func csvTokens(line string) []token {
+	return separatedTokens(line, ",")
+}
+
+// latexTokens splits the line at '&'
+//
+// @param string line The line to split
+//
+// @return []token
+func latexTokens(line string) []token {
+	return separatedTokens(line, "&")
+}
+
+// separatedTokens splits the line at the separator and removes the
+// whitespaces around the values
+//
+// An empty line has no values.
+//
+// @param string line The line to split
+// @param string sep  The separator between the values
+//
+// @return []token
+func separatedTokens(line, sep string) []token {
+	// an empty line has no values
+	if strings.TrimSpace(line) == "" {
+		return []token{}
+	}
+
+	// split the values
+	parts := strings.Split(line, sep)
+	tokens := make([]token, len(parts))
+
+	// remove the whitespaces and keep track of the column
+	col := 1
+	for i, part := range parts {
+		trimmed := strings.TrimLeft(part, " \t")
+		tokens[i] = token{
+			text: strings.TrimRight(trimmed, " \t"),
+			col:  col + len(part) - len(trimmed),
+		}
+
+		col += len(part) + len(sep)
+	}
+
+	return tokens
+}
+
+// numberToken is a non-negative number with its position in the input
+type numberToken struct {
+	value int
+	line  int
+	col   int
+}
+
+// parseNumbers reads the non-negative numbers of the line that are separated
+// by whitespaces
+//
+// @param string line   The line to read
+// @param int    lineNo The number of the line, starting at 1
+//
+// @return []numberToken, error
+func parseNumbers(line string, lineNo int) ([]numberToken, error) {
+	// split the values
+	tokens := prettyTokens(line)
+	numbers := make([]numberToken, len(tokens))
+
+	// convert the values
+	for i, value := range tokens {
+		number, err := strconv.Atoi(value.text)
+		if err != nil || number < 0 {
+			return nil, &ParseError{
+				Line: lineNo,
+				Col:  value.col,
+				Msg:  "invalid number \"" + value.text + "\"",
+			}
+		}
+
+		numbers[i] = numberToken{value: number, line: lineNo, col: value.col}
+	}
+
+	return numbers, nil
+}
+
+// endOfInput creates the error for input that ends too early
+//
+// The error points behind the last character of the lines.
+//
+// @param []string lines The lines of the input
+//
+// @return *ParseError
+func endOfInput(lines []string) *ParseError {
+	// an empty input ends in the first line
+	if len(lines) == 0 {
+		return &ParseError{Line: 1, Col: 1, Msg: "unexpected end of input"}
+	}
+
+	return &ParseError{
+		Line: len(lines),
+		Col:  len(lines[len(lines)-1]) + 1,
+		Msg:  "unexpected end of input",
+	}
+}
@@ -0,0 +1,249 @@
+package gomatrix
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	tests := []struct {
+		description string
+		write       func(*F2, *bytes.Buffer) error
+		parse       func(*bytes.Buffer) (*F2, error)
+	}{
+		{
+			description: "slim",
+			write:       func(f *F2, b *bytes.Buffer) error { return f.WriteSlim(b) },
+			parse:       func(b *bytes.Buffer) (*F2, error) { return ParseSlim(b) },
+		},
+		{
+			description: "pretty",
+			write:       func(f *F2, b *bytes.Buffer) error { return f.WritePretty(b) },
+			parse:       func(b *bytes.Buffer) (*F2, error) { return ParsePretty(b) },
+		},
+		{
+			description: "csv",
+			write:       func(f *F2, b *bytes.Buffer) error { return f.WriteCSV(b) },
+			parse:       func(b *bytes.Buffer) (*F2, error) { return ParseCSV(b) },
+		},
+		{
+			description: "latex",
+			write:       func(f *F2, b *bytes.Buffer) error { return f.WriteLaTex(b) },
+			parse:       func(b *bytes.Buffer) (*F2, error) { return ParseLaTeX(b) },
+		},
+		{
+			description: "detected",
+			write:       func(f *F2, b *bytes.Buffer) error { return f.WriteCSV(b) },
+			parse:       func(b *bytes.Buffer) (*F2, error) { return Parse(b) },
+		},
+	}
+
+	matrices := []*F2{
+		randomF2(7, 13, 1),
+		NewF2(3, 5),
+		NewF2(0, 0),
+	}
+
+	for _, test := range tests {
+		for _, matrix := range matrices {
+			var buffer bytes.Buffer
+
+			assert.Nilf(t, test.write(matrix, &buffer), test.description)
+
+			result, err := test.parse(&buffer)
+			assert.Nilf(t, err, test.description)
+			assert.Truef(t, matrix.IsEqual(result), test.description)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	expected := NewF2(2, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(6)})
+
+	tests := []struct {
+		description string
+		input       string
+	}{
+		{
+			description: "slim with trailing spaces",
+			input:       "101 \n011 \n",
+		},
+		{
+			description: "pretty with windows line endings",
+			input:       "1 0 1\r\n0  1 1\r\n",
+		},
+		{
+			description: "csv with trailing spaces",
+			input:       "1, 0, 1 \n0, 1, 1 \n",
+		},
+		{
+			description: "latex without final line break",
+			input:       "\\begin{bmatrix}\n1 & 0 & 1 \\\\\n0 & 1 & 1\n\\end{bmatrix}\n",
+		},
+		{
+			description: "text encoding",
+			input:       "2x3\n101\n011\n",
+		},
+	}
+
+	for _, test := range tests {
+		result, err := Parse(strings.NewReader(test.input))
+
+		assert.Nilf(t, err, test.description)
+		assert.Truef(t, expected.IsEqual(result), test.description)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		parse       func(string) (*F2, error)
+		line        int
+		col         int
+	}{
+		{
+			description: "invalid character in slim",
+			input:       "101\n021\n",
+			parse:       func(s string) (*F2, error) { return ParseSlim(strings.NewReader(s)) },
+			line:        2,
+			col:         2,
+		},
+		{
+			description: "short row in slim",
+			input:       "101\n01\n",
+			parse:       func(s string) (*F2, error) { return ParseSlim(strings.NewReader(s)) },
+			line:        2,
+			col:         3,
+		},
+		{
+			description: "long row in pretty",
+			input:       "1 0\n0 1  1\n",
+			parse:       func(s string) (*F2, error) { return ParsePretty(strings.NewReader(s)) },
+			line:        2,
+			col:         6,
+		},
+		{
+			description: "invalid value in csv",
+			input:       "1, 0\n0,  x\n",
+			parse:       func(s string) (*F2, error) { return ParseCSV(strings.NewReader(s)) },
+			line:        2,
+			col:         5,
+		},
+		{
+			description: "empty value in csv",
+			input:       "1, 0, 1\n0, , 1\n",
+			parse:       func(s string) (*F2, error) { return ParseCSV(strings.NewReader(s)) },
+			line:        2,
+			col:         4,
+		},
+		{
+			description: "missing latex environment",
+			input:       "1 & 0\\\\\n",
+			parse:       func(s string) (*F2, error) { return ParseLaTeX(strings.NewReader(s)) },
+			line:        1,
+			col:         1,
+		},
+		{
+			description: "unclosed latex environment",
+			input:       "\\begin{bmatrix}\n1 & 0\\\\\n",
+			parse:       func(s string) (*F2, error) { return ParseLaTeX(strings.NewReader(s)) },
+			line:        2,
+			col:         1,
+		},
+		{
+			description: "missing latex line break",
+			input:       "\\begin{bmatrix}\n1 & 0\n0 & 1\n\\end{bmatrix}\n",
+			parse:       func(s string) (*F2, error) { return ParseLaTeX(strings.NewReader(s)) },
+			line:        2,
+			col:         6,
+		},
+		{
+			description: "invalid value in latex",
+			input:       "\\begin{bmatrix}\n1 & 0\\\\\n0 & 2\\\\\n\\end{bmatrix}\n",
+			parse:       func(s string) (*F2, error) { return ParseLaTeX(strings.NewReader(s)) },
+			line:        3,
+			col:         5,
+		},
+		{
+			description: "detected pretty",
+			input:       "1 0\n1 0 1\n",
+			parse:       func(s string) (*F2, error) { return Parse(strings.NewReader(s)) },
+			line:        2,
+			col:         5,
+		},
+		{
+			description: "missing row in text encoding",
+			input:       "3x2\n10\n01\n",
+			parse:       func(s string) (*F2, error) { return Parse(strings.NewReader(s)) },
+			line:        3,
+			col:         3,
+		},
+		{
+			description: "additional row in text encoding",
+			input:       "1x2\n10\n01\n",
+			parse:       func(s string) (*F2, error) { return Parse(strings.NewReader(s)) },
+			line:        3,
+			col:         1,
+		},
+		{
+			description: "short row in text encoding",
+			input:       "2x3\n101\n01\n",
+			parse:       func(s string) (*F2, error) { return Parse(strings.NewReader(s)) },
+			line:        3,
+			col:         3,
+		},
+		{
+			description: "invalid value in text encoding",
+			input:       "2x3\n101\n0a1\n",
+			parse:       func(s string) (*F2, error) { return Parse(strings.NewReader(s)) },
+			line:        3,
+			col:         2,
+		},
+		{
+			description: "huge count of rows in text encoding",
+			input:       "2000000000x0\n",
+			parse:       func(s string) (*F2, error) { return Parse(strings.NewReader(s)) },
+			line:        1,
+			col:         13,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := test.parse(test.input)
+		assert.Nilf(t, result, test.description)
+
+		parseErr, ok := err.(*ParseError)
+		if !assert.Truef(t, ok, test.description) {
+			continue
+		}
+
+		assert.Equalf(t, test.line, parseErr.Line, test.description)
+		assert.Equalf(t, test.col, parseErr.Col, test.description)
+	}
+
+	err := &ParseError{Line: 2, Col: 3, Msg: "invalid value"}
+	assert.Equal(t, "line 2, column 3: invalid value", err.Error())
+}
+
+func TestParseReadError(t *testing.T) {
+	_, err := ParseSlim(failingReader{})
+	assert.Equal(t, errRead, err)
+
+	_, err = Parse(failingReader{})
+	assert.Equal(t, errRead, err)
+}
+
+// failingReader is a reader that always fails
+type failingReader struct{}
+
+var errRead = errors.New("read failed")
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errRead
+}
@@ -0,0 +1,312 @@
+package gomatrix
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Permutation represents a permutation of the indice 0 to len(p)-1
+//
+// The entry p[i] is the index that is moved to the index i, so applying p to
+// the columns of a matrix f moves the column p[i] of f to the column i. This
+// is the same as the multiplication f*P with the permutation matrix
+// P = p.ToMatrix(), which contains a 1 in the row p[i] of each column i.
+// In contrast to the permutation matrix, the permutation only needs one
+// integer per index.
+type Permutation []int
+
+// NewPermutation creates the identity permutation of n indice
+//
+// @param int n The count of indice
+//
+// @return Permutation
+func NewPermutation(n int) Permutation {
+	// initialize the permutation
+	p := make(Permutation, n)
+
+	// map each index to itself
+	for i := range p {
+		p[i] = i
+	}
+
+	return p
+}
+
+// PermutationFromMatrix converts a permutation matrix into a permutation
+//
+// If the matrix is not square or does not contain exactly one 1 in each row
+// and each column, ErrNotPermutation is returned.
+//
+// @param *F2 f The permutation matrix
+//
+// @return Permutation, error
+func PermutationFromMatrix(f *F2) (Permutation, error) {
+	// a permutation matrix needs to be square
+	if f.N != f.M {
+		return nil, ErrNotPermutation
+	}
+
+	// initialize the permutation and the indicator for the used columns
+	p := make(Permutation, f.N)
+	used := make([]bool, f.N)
+
+	// iterate through the rows
+	for k, row := range f.Rows {
+		// each row needs to contain exactly one 1
+		if row.BitLen() == 0 || row.TrailingZeroBits() != uint(row.BitLen()-1) {
+			return nil, ErrNotPermutation
+		}
+
+		// get the column of the 1
+		i := row.BitLen() - 1
+
+		// each column needs to contain exactly one 1
+		if used[i] {
+			return nil, ErrNotPermutation
+		}
+
+		// the row k is moved to the column i
+		used[i] = true
+		p[i] = k
+	}
+
+	return p, nil
+}
+
+// ToMatrix converts the permutation into a permutation matrix
+//
+// If the permutation is not valid, nil is returned.
+//
+// @return *F2|nil
+func (p Permutation) ToMatrix() *F2 {
+	// convert the permutation and drop the error
+	f, err := p.ToMatrixChecked()
+	if err != nil {
+		return nil
+	}
+
+	return f
+}
+
+// ToMatrixChecked converts the permutation into a permutation matrix
+//
+// This function works like ToMatrix, but it returns ErrNotPermutation
+// instead of nil if the permutation is not valid.
+//
+// @return *F2, error
+func (p Permutation) ToMatrixChecked() (*F2, error) {
+	// the indice need to be in range and unique
+	if !p.IsValid() {
+		return nil, ErrNotPermutation
+	}
+
+	// initialize the permutation matrix
+	f := NewF2(len(p), len(p))
+
+	// set the 1 in each column
+	for i, k := range p {
+		f.Rows[k].SetBit(f.Rows[k], i, 1)
+	}
+
+	return f, nil
+}
+
+// IsValid checks if each index occurs exactly once
+//
+// @return bool
+func (p Permutation) IsValid() bool {
+	// initialize the indicator for the used indice
+	used := make([]bool, len(p))
+
+	// iterate through the indice
+	for _, k := range p {
+		// the index needs to be in range and must not be used twice
+		if k < 0 || k >= len(p) || used[k] {
+			return false
+		}
+
+		used[k] = true
+	}
+
+	return true
+}
+
+// Swap swaps the entries at index i and j
+//
+// This is the same as swapping the columns i and j of the permutation matrix.
+//
+// @param int i The first index to swap
+// @param int j The second index to swap
+//
+// @return error
+func (p Permutation) Swap(i, j int) error {
+	// check for input parameters
+	if i >= len(p) || j >= len(p) || i < 0 || j < 0 {
+		return ErrIndexOutOfRange
+	}
+
+	// swap the entries
+	p[i], p[j] = p[j], p[i]
+
+	return nil
+}
+
+// Compose returns the permutation that applies p and then q
+//
+// The permutation matrix of the result is the product P*Q of the permutation
+// matrices. If p or q is not valid, ErrNotPermutation is returned.
+//
+// @param Permutation q The permutation to apply after p
+//
+// @return Permutation, error
+func (p Permutation) Compose(q Permutation) (Permutation, error) {
+	// both permutations need to be valid
+	if !p.IsValid() || !q.IsValid() {
+		return nil, ErrNotPermutation
+	}
+
+	// the permutations need the same size
+	if len(p) != len(q) {
+		return nil, ErrDimensionMismatch
+	}
+
+	// initialize the result
+	result := make(Permutation, len(p))
+
+	// the index q[i] is moved to i, where p moved the index p[q[i]] before
+	for i, k := range q {
+		result[i] = p[k]
+	}
+
+	return result, nil
+}
+
+// Inverse returns the inverse permutation
+//
+// The permutation matrix of the result is the transposed permutation matrix.
+// If the permutation is not valid, nil is returned.
+//
+// @return Permutation|nil
+func (p Permutation) Inverse() Permutation {
+	// invert the permutation and drop the error
+	result, err := p.InverseChecked()
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+// InverseChecked returns the inverse permutation
+//
+// This function works like Inverse, but it returns ErrNotPermutation instead
+// of nil if the permutation is not valid.
+//
+// @return Permutation, error
+func (p Permutation) InverseChecked() (Permutation, error) {
+	// the indice need to be in range and unique
+	if !p.IsValid() {
+		return nil, ErrNotPermutation
+	}
+
+	// initialize the result
+	result := make(Permutation, len(p))
+
+	// move each index back
+	for i, k := range p {
+		result[k] = i
+	}
+
+	return result, nil
+}
+
+// ApplyToCols permutes the columns of the matrix
+//
+// The column p[i] of f is moved to the column i, so the result is f*P. If p
+// is not valid, ErrNotPermutation is returned and f is not modified.
+//
+// @param *F2 f The matrix to permute
+//
+// @return error
+func (p Permutation) ApplyToCols(f *F2) error {
+	// the indice need to be in range and unique
+	if !p.IsValid() {
+		return ErrNotPermutation
+	}
+
+	// the permutation needs an index for each column
+	if len(p) != f.M {
+		return ErrDimensionMismatch
+	}
+
+	// initialize the buffer for the permuted words
+	words := make([]big.Word, (f.M+bits.UintSize-1)/bits.UintSize)
+
+	// iterate through the rows
+	for _, row := range f.Rows {
+		// clear the buffer
+		for w := range words {
+			words[w] = 0
+		}
+
+		// collect the permuted bits
+		for i, k := range p {
+			words[i/bits.UintSize] |= big.Word(row.Bit(k)) << uint(i%bits.UintSize)
+		}
+
+		// save the permuted row
+		row.SetBits(append([]big.Word(nil), words...))
+	}
+
+	return nil
+}
+
+// ApplyToRows permutes the rows of the matrix
+//
+// The row p[i] of f is moved to the row i, so the result is P^T*f. If p is
+// not valid, ErrNotPermutation is returned and f is not modified.
+//
+// @param *F2 f The matrix to permute
+//
+// @return error
+func (p Permutation) ApplyToRows(f *F2) error {
+	// the indice need to be in range and unique
+	if !p.IsValid() {
+		return ErrNotPermutation
+	}
+
+	// the permutation needs an index for each row
+	if len(p) != f.N {
+		return ErrDimensionMismatch
+	}
+
+	// initialize the permuted rows
+	rows := make([]*big.Int, f.N)
+
+	// move the rows
+	for i, k := range p {
+		rows[i] = f.Rows[k]
+	}
+
+	// save the permuted rows
+	f.Rows = rows
+
+	return nil
+}
+
+// PermuteColsCompact permutes the columns of the matrix randomly
+//
+// This function works like PermuteCols, but it returns the permutation
+// instead of the permutation matrix. The permutation is created with
+// crypto/rand. If crypto/rand fails, the function panics.
+//
+// @return Permutation
+func (f *F2) PermuteColsCompact() Permutation {
+	// permute the columns with crypto/rand
+	p, err := f.PermuteColsFrom(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
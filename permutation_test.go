@@ -0,0 +1,210 @@
+package gomatrix
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermutationFromMatrix(t *testing.T) {
+	tests := []struct {
+		description         string
+		matrix              *F2
+		expectedPermutation Permutation
+		expectedError       error
+	}{
+		{
+			description:         "identity matrix",
+			matrix:              NewF2(3, 3).SetToIdentity(),
+			expectedPermutation: Permutation{0, 1, 2},
+		},
+		{
+			description:         "permutation matrix",
+			matrix:              NewF2(3, 3).Set([]*big.Int{big.NewInt(4), big.NewInt(1), big.NewInt(2)}),
+			expectedPermutation: Permutation{1, 2, 0},
+		},
+		{
+			description:   "two bits in a row",
+			matrix:        NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(2), big.NewInt(0)}),
+			expectedError: ErrNotPermutation,
+		},
+		{
+			description:   "two bits in a column",
+			matrix:        NewF2(3, 3).Set([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(2)}),
+			expectedError: ErrNotPermutation,
+		},
+		{
+			description:   "non square matrix",
+			matrix:        NewF2(2, 3),
+			expectedError: ErrNotPermutation,
+		},
+	}
+
+	for _, test := range tests {
+		p, err := PermutationFromMatrix(test.matrix)
+
+		assert.Equalf(t, test.expectedError, err, test.description)
+
+		if err != nil {
+			continue
+		}
+
+		assert.Equalf(t, test.expectedPermutation, p, test.description)
+		assert.Truef(t, test.matrix.IsEqual(p.ToMatrix()), test.description)
+	}
+}
+
+func TestPermutationIsValid(t *testing.T) {
+	assert.True(t, Permutation{2, 0, 1}.IsValid())
+	assert.True(t, NewPermutation(0).IsValid())
+	assert.False(t, Permutation{0, 0, 1}.IsValid())
+	assert.False(t, Permutation{0, 3, 1}.IsValid())
+	assert.False(t, Permutation{0, -1, 1}.IsValid())
+}
+
+func TestPermutationSwap(t *testing.T) {
+	p := NewPermutation(4)
+	f := NewF2(4, 4).SetToIdentity()
+
+	assert.Nil(t, p.Swap(0, 3))
+	assert.Nil(t, f.SwapCols(0, 3))
+	assert.Nil(t, p.Swap(1, 3))
+	assert.Nil(t, f.SwapCols(1, 3))
+
+	assert.True(t, f.IsEqual(p.ToMatrix()))
+	assert.Equal(t, ErrIndexOutOfRange, p.Swap(4, 0))
+	assert.Equal(t, ErrIndexOutOfRange, p.Swap(0, -1))
+}
+
+func TestPermutationCompose(t *testing.T) {
+	tests := []struct {
+		description   string
+		p             Permutation
+		q             Permutation
+		expectedError error
+	}{
+		{
+			description: "3 indice",
+			p:           Permutation{1, 2, 0},
+			q:           Permutation{0, 2, 1},
+		},
+		{
+			description: "inverse",
+			p:           Permutation{3, 0, 4, 1, 2},
+			q:           Permutation{3, 0, 4, 1, 2}.Inverse(),
+		},
+		{
+			description:   "different sizes",
+			p:             Permutation{1, 0},
+			q:             Permutation{0, 1, 2},
+			expectedError: ErrDimensionMismatch,
+		},
+		{
+			description:   "duplicate index",
+			p:             Permutation{1, 1, 0},
+			q:             Permutation{0, 2, 1},
+			expectedError: ErrNotPermutation,
+		},
+		{
+			description:   "index out of range",
+			p:             Permutation{1, 2, 0},
+			q:             Permutation{0, 3, 1},
+			expectedError: ErrNotPermutation,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := test.p.Compose(test.q)
+
+		assert.Equalf(t, test.expectedError, err, test.description)
+
+		if err != nil {
+			continue
+		}
+
+		product := test.p.ToMatrix().MulMatrix(test.q.ToMatrix())
+
+		assert.Truef(t, product.IsEqual(result.ToMatrix()), test.description)
+	}
+}
+
+func TestPermutationInverse(t *testing.T) {
+	p := Permutation{3, 0, 4, 1, 2}
+
+	assert.True(t, p.ToMatrix().T().IsEqual(p.Inverse().ToMatrix()))
+
+	identity, err := p.Compose(p.Inverse())
+
+	assert.Nil(t, err)
+	assert.Equal(t, NewPermutation(5), identity)
+}
+
+func TestPermutationApply(t *testing.T) {
+	p := Permutation{3, 0, 4, 1, 2}
+
+	// columns
+	matrix := randomF2(4, 5, 1)
+	expected := NewF2(4, 5).Set(matrix.Rows).MulMatrix(p.ToMatrix())
+
+	assert.Nil(t, p.ApplyToCols(matrix))
+	assert.True(t, expected.IsEqual(matrix))
+	assert.Equal(t, ErrDimensionMismatch, p.ApplyToCols(NewF2(5, 4)))
+
+	// rows
+	matrix = randomF2(5, 3, 2)
+	expected = p.ToMatrix().T().MulMatrix(matrix)
+
+	assert.Nil(t, p.ApplyToRows(matrix))
+	assert.True(t, expected.IsEqual(matrix))
+	assert.Equal(t, ErrDimensionMismatch, p.ApplyToRows(NewF2(4, 5)))
+
+	// large matrix across word boundaries
+	matrix = randomF2(3, 150, 3)
+	saved := NewF2(3, 150).Set(matrix.Rows)
+	q := matrix.PermuteColsCompact()
+
+	assert.True(t, q.IsValid())
+	assert.True(t, saved.MulMatrix(q.ToMatrix()).IsEqual(matrix))
+}
+
+func TestPermutationInvalid(t *testing.T) {
+	tests := []struct {
+		description string
+		p           Permutation
+	}{
+		{
+			description: "index out of range",
+			p:           Permutation{0, 5},
+		},
+		{
+			description: "duplicate index",
+			p:           Permutation{1, 1},
+		},
+		{
+			description: "negative index",
+			p:           Permutation{-1, 0},
+		},
+	}
+
+	for _, test := range tests {
+		matrix := randomF2(2, 2, 4)
+		saved := matrix.Clone()
+
+		assert.Equalf(t, ErrNotPermutation, test.p.ApplyToRows(matrix), test.description)
+		assert.Equalf(t, ErrNotPermutation, test.p.ApplyToCols(matrix), test.description)
+
+		// the matrix is not modified
+		assert.Truef(t, saved.IsEqual(matrix), test.description)
+
+		// the conversions reject the permutation instead of panicking
+		assert.Nilf(t, test.p.ToMatrix(), test.description)
+		assert.Nilf(t, test.p.Inverse(), test.description)
+
+		_, err := test.p.ToMatrixChecked()
+		assert.Equalf(t, ErrNotPermutation, err, test.description)
+
+		_, err = test.p.InverseChecked()
+		assert.Equalf(t, ErrNotPermutation, err, test.description)
+	}
+}
@@ -1,44 +1,162 @@
 package gomatrix
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
+	"os"
 )
 
 // PrettyPrint prints the matrix to stdout
 func (f *F2) PrettyPrint() {
-	f.printWithSeparators(" ", "\n")
+	f.WritePretty(os.Stdout)
 }
 
 // PrintSlim prints the matrix without whitespaces to stdout
 func (f *F2) PrintSlim() {
-	f.printWithSeparators("", "\n")
+	f.WriteSlim(os.Stdout)
 }
 
 // PrintLaTex prints the matrix as latex code
 func (f *F2) PrintLaTex() {
-	fmt.Printf("\\begin{bmatrix}\n")
-	f.printWithSeparators(" & ", "\\\\\n")
-	fmt.Printf("\\end{bmatrix}\n")
+	f.WriteLaTex(os.Stdout)
 }
 
 // PrintCSV prints the matrix as csv
 func (f *F2) PrintCSV() {
-	f.printWithSeparators(", ", "\n")
+	f.WriteCSV(os.Stdout)
 }
 
-// printWithSeparators prints the matrix with custom seperators
+// WritePretty writes the matrix with the values separated by spaces
 //
-// @param string valSep  The separator for the single values
-// @param string lineSep The line separator
-func (f *F2) printWithSeparators(valSep, lineSep string) {
+// Each row is written to its own line, e.g. "1 0 1\n0 1 1\n".
+//
+// @param io.Writer w The writer to write the matrix to
+//
+// @return error
+func (f *F2) WritePretty(w io.Writer) error {
+	return f.writeWithSeparators(w, " ", "\n")
+}
+
+// WriteSlim writes the matrix without whitespaces
+//
+// Each row is written to its own line, e.g. "101\n011\n".
+//
+// @param io.Writer w The writer to write the matrix to
+//
+// @return error
+func (f *F2) WriteSlim(w io.Writer) error {
+	return f.writeWithSeparators(w, "", "\n")
+}
+
+// WriteLaTex writes the matrix as latex code
+//
+// The rows are wrapped in a bmatrix environment.
+//
+// @param io.Writer w The writer to write the matrix to
+//
+// @return error
+func (f *F2) WriteLaTex(w io.Writer) error {
+	// buffer the output, the writer keeps the first error
+	writer := bufio.NewWriter(w)
+
+	writer.WriteString("\\begin{bmatrix}\n")
+	f.writeRows(writer, " & ", "\\\\\n")
+	writer.WriteString("\\end{bmatrix}\n")
+
+	return writer.Flush()
+}
+
+// WriteCSV writes the matrix as csv
+//
+// @param io.Writer w The writer to write the matrix to
+//
+// @return error
+func (f *F2) WriteCSV(w io.Writer) error {
+	return f.writeWithSeparators(w, ", ", "\n")
+}
+
+// String returns the matrix in the layout of WritePretty
+//
+// @return string
+func (f *F2) String() string {
+	// initialize the buffer
+	var buffer bytes.Buffer
+
+	// writing to a buffer does not fail
+	f.WritePretty(&buffer)
+
+	return buffer.String()
+}
+
+// Format implements fmt.Formatter, so the verb chooses the layout
+//
+// %v and %s use the layout of WritePretty, %+v additionally writes the
+// dimensions as "NxM" in the first line. %q quotes the result of String.
+// The custom verbs %b, %l and %c use the layouts of WriteSlim, WriteLaTex
+// and WriteCSV.
+//
+// @param fmt.State state The state of the formatter
+// @param rune      verb  The verb of the format
+func (f *F2) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		// write the dimensions if requested
+		if state.Flag('+') {
+			fmt.Fprintf(state, "%dx%d\n", f.N, f.M)
+		}
+
+		f.WritePretty(state)
+	case 's':
+		f.WritePretty(state)
+	case 'q':
+		fmt.Fprintf(state, "%q", f.String())
+	case 'b':
+		f.WriteSlim(state)
+	case 'l':
+		f.WriteLaTex(state)
+	case 'c':
+		f.WriteCSV(state)
+	default:
+		// report the unknown verb like the fmt package
+		fmt.Fprintf(state, "%%!%c(*gomatrix.F2=%dx%d)", verb, f.N, f.M)
+	}
+}
+
+// writeWithSeparators writes the matrix with custom seperators
+//
+// @param io.Writer w       The writer to write the matrix to
+// @param string    valSep  The separator for the single values
+// @param string    lineSep The line separator
+//
+// @return error
+func (f *F2) writeWithSeparators(w io.Writer, valSep, lineSep string) error {
+	// buffer the output, the writer keeps the first error
+	writer := bufio.NewWriter(w)
+
+	f.writeRows(writer, valSep, lineSep)
+
+	return writer.Flush()
+}
+
+// writeRows writes the rows of the matrix with custom seperators
+//
+// @param *bufio.Writer writer  The writer to write the rows to
+// @param string        valSep  The separator for the single values
+// @param string        lineSep The line separator
+func (f *F2) writeRows(writer *bufio.Writer, valSep, lineSep string) {
+	// iterate through the rows
 	for _, row := range f.Rows {
+		// write the values with the separator between them
 		for i := 0; i < f.M; i++ {
-			if i == f.M-1 {
-				fmt.Printf("%d ", row.Bit(i))
-				continue
+			if i > 0 {
+				writer.WriteString(valSep)
 			}
-			fmt.Printf("%d%s", row.Bit(i), valSep)
+
+			writer.WriteByte(byte('0' + row.Bit(i)))
 		}
-		fmt.Print(lineSep)
+
+		writer.WriteString(lineSep)
 	}
 }
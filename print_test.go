@@ -2,8 +2,13 @@
 package gomatrix
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"math/big"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestPrettyPrint(t *testing.T) {
@@ -22,3 +27,107 @@ func TestPrettyPrint(t *testing.T) {
 		test.matrix.PrintCSV()
 	}
 }
+
+func TestWrite(t *testing.T) {
+	matrix := NewF2(2, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(6)})
+
+	tests := []struct {
+		description string
+		write       func(*F2, *bytes.Buffer) error
+		expected    string
+	}{
+		{
+			description: "pretty",
+			write:       func(f *F2, b *bytes.Buffer) error { return f.WritePretty(b) },
+			expected:    "1 0 1\n0 1 1\n",
+		},
+		{
+			description: "slim",
+			write:       func(f *F2, b *bytes.Buffer) error { return f.WriteSlim(b) },
+			expected:    "101\n011\n",
+		},
+		{
+			description: "latex",
+			write:       func(f *F2, b *bytes.Buffer) error { return f.WriteLaTex(b) },
+			expected:    "\\begin{bmatrix}\n1 & 0 & 1\\\\\n0 & 1 & 1\\\\\n\\end{bmatrix}\n",
+		},
+		{
+			description: "csv",
+			write:       func(f *F2, b *bytes.Buffer) error { return f.WriteCSV(b) },
+			expected:    "1, 0, 1\n0, 1, 1\n",
+		},
+	}
+
+	for _, test := range tests {
+		var buffer bytes.Buffer
+
+		assert.Nilf(t, test.write(matrix, &buffer), test.description)
+		assert.Equalf(t, test.expected, buffer.String(), test.description)
+	}
+}
+
+// failingWriter is a writer that always fails
+type failingWriter struct{}
+
+var errWrite = errors.New("write failed")
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errWrite
+}
+
+func TestWriteError(t *testing.T) {
+	matrix := randomF2(3, 4, 1)
+
+	assert.Equal(t, errWrite, matrix.WritePretty(failingWriter{}))
+	assert.Equal(t, errWrite, matrix.WriteSlim(failingWriter{}))
+	assert.Equal(t, errWrite, matrix.WriteLaTex(failingWriter{}))
+	assert.Equal(t, errWrite, matrix.WriteCSV(failingWriter{}))
+}
+
+func TestFormat(t *testing.T) {
+	matrix := NewF2(2, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(6)})
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format:   "%v",
+			expected: "1 0 1\n0 1 1\n",
+		},
+		{
+			format:   "%+v",
+			expected: "2x3\n1 0 1\n0 1 1\n",
+		},
+		{
+			format:   "%s",
+			expected: "1 0 1\n0 1 1\n",
+		},
+		{
+			format:   "%q",
+			expected: `"1 0 1\n0 1 1\n"`,
+		},
+		{
+			format:   "%b",
+			expected: "101\n011\n",
+		},
+		{
+			format:   "%l",
+			expected: "\\begin{bmatrix}\n1 & 0 & 1\\\\\n0 & 1 & 1\\\\\n\\end{bmatrix}\n",
+		},
+		{
+			format:   "%c",
+			expected: "1, 0, 1\n0, 1, 1\n",
+		},
+		{
+			format:   "%d",
+			expected: "%!d(*gomatrix.F2=2x3)",
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equalf(t, test.expected, fmt.Sprintf(test.format, matrix), test.format)
+	}
+
+	assert.Equal(t, "1 0 1\n0 1 1\n", matrix.String())
+}
@@ -0,0 +1,345 @@
+package gomatrix
+
+import (
+	"math/big"
+)
+
+// QuasiCyclic represents a matrix in F_2 that consists of circulant blocks
+//
+// The matrix is a grid of BlockRows x BlockCols blocks, where each block is a
+// circulant R x R matrix. Only the first row of each block is stored, the row
+// k of a block is the first row cyclically shifted by k columns. The first
+// row with the bits c_0 to c_(R-1) equals the polynomial
+// c(x) = c_0 + c_1*x + ... + c_(R-1)*x^(R-1), so the row k is x^k*c(x)
+// mod x^R-1 and the product of two circulant blocks is the product of their
+// polynomials.
+type QuasiCyclic struct {
+	BlockRows int
+	BlockCols int
+	R         int
+	Blocks    [][]*big.Int
+}
+
+// NewQuasiCyclic creates a new quasi-cyclic matrix with zero blocks
+//
+// @param int blockRows The count of block rows
+// @param int blockCols The count of block columns
+// @param int r         The size of the circulant blocks
+//
+// @return *QuasiCyclic
+func NewQuasiCyclic(blockRows, blockCols, r int) *QuasiCyclic {
+	// initialize the blocks
+	blocks := make([][]*big.Int, blockRows)
+
+	// iterate through the block rows
+	for i := range blocks {
+		blocks[i] = make([]*big.Int, blockCols)
+
+		// create the zero blocks
+		for j := range blocks[i] {
+			blocks[i][j] = big.NewInt(0)
+		}
+	}
+
+	// return the matrix
+	return &QuasiCyclic{
+		BlockRows: blockRows,
+		BlockCols: blockCols,
+		R:         r,
+		Blocks:    blocks,
+	}
+}
+
+// SetBlock sets the first row of the block at index i, j
+//
+// @param int      i        The block row index
+// @param int      j        The block column index
+// @param *big.Int firstRow The first row of the circulant block
+//
+// @return error
+func (q *QuasiCyclic) SetBlock(i, j int, firstRow *big.Int) error {
+	// check if the indice are in the matrix
+	if i < 0 || j < 0 || i >= q.BlockRows || j >= q.BlockCols {
+		return ErrIndexOutOfRange
+	}
+
+	// the first row needs to fit into the block
+	if firstRow.Sign() < 0 || firstRow.BitLen() > q.R {
+		return ErrDimensionMismatch
+	}
+
+	// save a copy of the row
+	q.Blocks[i][j] = big.NewInt(0).Set(firstRow)
+
+	return nil
+}
+
+// ToF2 expands the matrix into a dense matrix
+//
+// @return *F2
+func (q *QuasiCyclic) ToF2() *F2 {
+	// create the dense matrix
+	f := NewF2(q.BlockRows*q.R, q.BlockCols*q.R)
+
+	// iterate through the blocks
+	for i, blockRow := range q.Blocks {
+		for j, block := range blockRow {
+			// set each row of the circulant block
+			for k := 0; k < q.R; k++ {
+				// shift the first row by k columns
+				row := circulantRow(block, k, q.R)
+
+				// move the row into the block column
+				row.Lsh(row, uint(j*q.R))
+				f.Rows[i*q.R+k].Or(f.Rows[i*q.R+k], row)
+			}
+		}
+	}
+
+	return f
+}
+
+// IsEqual checks the equality of the matrix objects
+//
+// @param *QuasiCyclic m The matrix to compare with
+//
+// @return bool
+func (q *QuasiCyclic) IsEqual(m *QuasiCyclic) bool {
+	// compare the sizes
+	if q.BlockRows != m.BlockRows || q.BlockCols != m.BlockCols || q.R != m.R {
+		return false
+	}
+
+	// compare the blocks
+	for i, blockRow := range q.Blocks {
+		for j, block := range blockRow {
+			if block.Cmp(m.Blocks[i][j]) != 0 {
+				return false
+			}
+		}
+	}
+
+	// size and values are equal
+	return true
+}
+
+// AddMatrix adds the matrix m to q
+//
+// @param *QuasiCyclic m The matrix to add
+//
+// @return *QuasiCyclic|nil
+func (q *QuasiCyclic) AddMatrix(m *QuasiCyclic) *QuasiCyclic {
+	// if the dimensions are different...
+	if q.BlockRows != m.BlockRows || q.BlockCols != m.BlockCols || q.R != m.R {
+		// ...return an error
+		return nil
+	}
+
+	// add the blocks
+	for i, blockRow := range q.Blocks {
+		for j, block := range blockRow {
+			block.Xor(block, m.Blocks[i][j])
+		}
+	}
+
+	return q
+}
+
+// MulMatrix multiplies the matrix q with m
+//
+// Each block of the result is the sum of the products of the circulant
+// blocks, which are calculated as polynomials mod x^R-1. The result is saved
+// in q.
+//
+// @param *QuasiCyclic m The matrix to multiply with
+//
+// @return *QuasiCyclic|nil
+func (q *QuasiCyclic) MulMatrix(m *QuasiCyclic) *QuasiCyclic {
+	// if the dimensions do not fit for a multiplication...
+	if q.BlockCols != m.BlockRows || q.R != m.R {
+		// ...return an error
+		return nil
+	}
+
+	// create the result matrix
+	result := NewQuasiCyclic(q.BlockRows, m.BlockCols, q.R)
+
+	// iterate through the blocks of the result
+	for i := range result.Blocks {
+		for j, block := range result.Blocks[i] {
+			// sum up the products of the blocks
+			for k := 0; k < q.BlockCols; k++ {
+				block.Xor(block, polyMulMod(q.Blocks[i][k], m.Blocks[k][j], q.R))
+			}
+		}
+	}
+
+	// save the result matrix in q
+	q.BlockRows, q.BlockCols, q.Blocks = result.BlockRows, result.BlockCols, result.Blocks
+
+	return q
+}
+
+// T transposes matrix q
+//
+// The grid of blocks is transposed and each circulant block c(x) is replaced
+// by its transpose c(x^-1) mod x^R-1.
+func (q *QuasiCyclic) T() *QuasiCyclic {
+	// create the result matrix
+	result := NewQuasiCyclic(q.BlockCols, q.BlockRows, q.R)
+
+	// iterate through the blocks
+	for i, blockRow := range q.Blocks {
+		for j, block := range blockRow {
+			result.Blocks[j][i] = circulantTranspose(block, q.R)
+		}
+	}
+
+	// save the result matrix
+	q.BlockRows, q.BlockCols, q.Blocks = result.BlockRows, result.BlockCols, result.Blocks
+
+	return q
+}
+
+// InvertCirculant inverts the circulant r x r matrix with the given first row
+//
+// The inverse is the polynomial u(x) with c(x)*u(x) = 1 mod x^r-1, which is
+// calculated with the extended euclidean algorithm. If c(x) and x^r-1 have a
+// common factor, ErrSingular is returned.
+//
+// @param *big.Int firstRow The first row of the circulant matrix
+// @param int      r        The size of the circulant matrix
+//
+// @return *big.Int, error
+func InvertCirculant(firstRow *big.Int, r int) (*big.Int, error) {
+	// the first row needs to fit into the matrix
+	if firstRow.Sign() < 0 || firstRow.BitLen() > r {
+		return nil, ErrDimensionMismatch
+	}
+
+	// create the modulus x^r-1, which is x^r+1 in F_2
+	modulus := big.NewInt(1)
+	modulus.SetBit(modulus, r, 1)
+
+	// initialize the remainders and the coefficients of c(x), so that
+	// u * c(x) = a mod x^r-1 holds for each pair u, a
+	a, b := big.NewInt(0).Set(firstRow), modulus
+	u, v := big.NewInt(1), big.NewInt(0)
+
+	// reduce until the remainder is 0
+	for a.Sign() != 0 {
+		// divide the remainders
+		quotient, remainder := polyDivMod(b, a)
+
+		// continue with the next remainder and its coefficient
+		a, b = remainder, a
+		u, v = big.NewInt(0).Xor(v, polyMul(quotient, u)), u
+	}
+
+	// if the greatest common divisor is not 1...
+	if b.Cmp(big.NewInt(1)) != 0 {
+		// ...c(x) has no inverse
+		return nil, ErrSingular
+	}
+
+	// reduce the coefficient mod x^r-1
+	return polyMulMod(v, big.NewInt(1), r), nil
+}
+
+// circulantRow returns the row k of the circulant block with the first row c
+//
+// @param *big.Int c The first row of the block
+// @param int      k The row index
+// @param int      r The size of the block
+//
+// @return *big.Int
+func circulantRow(c *big.Int, k, r int) *big.Int {
+	// multiply c(x) with x^k
+	return polyMulMod(c, big.NewInt(0).SetBit(big.NewInt(0), k, 1), r)
+}
+
+// circulantTranspose returns the first row of the transposed circulant block
+//
+// @param *big.Int c The first row of the block
+// @param int      r The size of the block
+//
+// @return *big.Int
+func circulantTranspose(c *big.Int, r int) *big.Int {
+	// initialize the result
+	result := big.NewInt(0)
+
+	// move the bit k to the bit -k mod r
+	for k := 0; k < c.BitLen(); k++ {
+		if c.Bit(k) == 1 {
+			result.SetBit(result, (r-k)%r, 1)
+		}
+	}
+
+	return result
+}
+
+// polyMul multiplies the polynomials a and b in F_2[x]
+//
+// @param *big.Int a The first polynomial
+// @param *big.Int b The second polynomial
+//
+// @return *big.Int
+func polyMul(a, b *big.Int) *big.Int {
+	// initialize the result
+	result := big.NewInt(0)
+
+	// add b*x^k for each coefficient k of a
+	for k := 0; k < a.BitLen(); k++ {
+		if a.Bit(k) == 1 {
+			result.Xor(result, big.NewInt(0).Lsh(b, uint(k)))
+		}
+	}
+
+	return result
+}
+
+// polyMulMod multiplies the polynomials a and b in F_2[x] mod x^r-1
+//
+// @param *big.Int a The first polynomial
+// @param *big.Int b The second polynomial
+// @param int      r The degree of the modulus
+//
+// @return *big.Int
+func polyMulMod(a, b *big.Int, r int) *big.Int {
+	// multiply the polynomials
+	result := polyMul(a, b)
+
+	// create the mask for the coefficients below x^r
+	mask := big.NewInt(1)
+	mask.Lsh(mask, uint(r)).Sub(mask, big.NewInt(1))
+
+	// as x^r = 1, the coefficients from x^r on are added to the lower ones
+	for result.BitLen() > r {
+		high := big.NewInt(0).Rsh(result, uint(r))
+		result.And(result, mask).Xor(result, high)
+	}
+
+	return result
+}
+
+// polyDivMod divides the polynomial a by b in F_2[x]
+//
+// @param *big.Int a The dividend
+// @param *big.Int b The divisor, which must not be 0
+//
+// @return *big.Int, *big.Int The quotient and the remainder
+func polyDivMod(a, b *big.Int) (*big.Int, *big.Int) {
+	// initialize the quotient and the remainder
+	quotient := big.NewInt(0)
+	remainder := big.NewInt(0).Set(a)
+
+	// remove the leading coefficient until the degree is smaller than b's
+	for remainder.BitLen() >= b.BitLen() {
+		shift := remainder.BitLen() - b.BitLen()
+
+		quotient.SetBit(quotient, shift, 1)
+		remainder.Xor(remainder, big.NewInt(0).Lsh(b, uint(shift)))
+	}
+
+	return quotient, remainder
+}
@@ -0,0 +1,168 @@
+package gomatrix
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// randomQuasiCyclic creates a reproducible random quasi-cyclic matrix for the
+// tests
+func randomQuasiCyclic(blockRows, blockCols, r int, seed int64) *QuasiCyclic {
+	rng := rand.New(rand.NewSource(seed))
+
+	matrix := NewQuasiCyclic(blockRows, blockCols, r)
+
+	for _, blockRow := range matrix.Blocks {
+		for _, block := range blockRow {
+			for k := 0; k < r; k++ {
+				block.SetBit(block, k, uint(rng.Intn(2)))
+			}
+		}
+	}
+
+	return matrix
+}
+
+func TestQuasiCyclicToF2(t *testing.T) {
+	matrix := NewQuasiCyclic(1, 2, 3)
+	assert.Nil(t, matrix.SetBlock(0, 0, big.NewInt(3)))
+	assert.Nil(t, matrix.SetBlock(0, 1, big.NewInt(4)))
+
+	expected := NewF2(3, 6).Set([]*big.Int{
+		big.NewInt(35),
+		big.NewInt(14),
+		big.NewInt(21),
+	})
+
+	assert.True(t, expected.IsEqual(matrix.ToF2()))
+
+	assert.Equal(t, ErrIndexOutOfRange, matrix.SetBlock(1, 0, big.NewInt(1)))
+	assert.Equal(t, ErrDimensionMismatch, matrix.SetBlock(0, 0, big.NewInt(8)))
+}
+
+func TestQuasiCyclicAddMatrix(t *testing.T) {
+	a := randomQuasiCyclic(2, 3, 17, 1)
+	b := randomQuasiCyclic(2, 3, 17, 2)
+
+	expected := a.ToF2().AddMatrix(b.ToF2())
+
+	assert.True(t, expected.IsEqual(a.AddMatrix(b).ToF2()))
+	assert.Nil(t, a.AddMatrix(randomQuasiCyclic(2, 3, 16, 3)))
+}
+
+func TestQuasiCyclicMulMatrix(t *testing.T) {
+	tests := []struct {
+		description string
+		a           *QuasiCyclic
+		b           *QuasiCyclic
+	}{
+		{
+			description: "single circulants",
+			a:           randomQuasiCyclic(1, 1, 13, 4),
+			b:           randomQuasiCyclic(1, 1, 13, 5),
+		},
+		{
+			description: "block matrices",
+			a:           randomQuasiCyclic(2, 3, 11, 6),
+			b:           randomQuasiCyclic(3, 2, 11, 7),
+		},
+		{
+			description: "large blocks",
+			a:           randomQuasiCyclic(1, 2, 131, 8),
+			b:           randomQuasiCyclic(2, 1, 131, 9),
+		},
+	}
+
+	for _, test := range tests {
+		expected := test.a.ToF2().MulMatrix(test.b.ToF2())
+
+		assert.Truef(t, expected.IsEqual(test.a.MulMatrix(test.b).ToF2()), test.description)
+	}
+
+	assert.Nil(t, randomQuasiCyclic(2, 3, 11, 10).MulMatrix(randomQuasiCyclic(2, 3, 11, 11)))
+}
+
+func TestQuasiCyclicT(t *testing.T) {
+	matrix := randomQuasiCyclic(2, 3, 19, 12)
+
+	expected := matrix.ToF2().T()
+
+	assert.True(t, expected.IsEqual(matrix.T().ToF2()))
+	assert.Equal(t, 3, matrix.BlockRows)
+	assert.Equal(t, 2, matrix.BlockCols)
+}
+
+func TestInvertCirculant(t *testing.T) {
+	tests := []struct {
+		description string
+		firstRow    *big.Int
+		r           int
+		err         error
+	}{
+		{
+			description: "identity",
+			firstRow:    big.NewInt(1),
+			r:           5,
+		},
+		{
+			description: "invertible circulant",
+			firstRow:    big.NewInt(7),
+			r:           7,
+		},
+		{
+			description: "even weight is divisible by x+1",
+			firstRow:    big.NewInt(3),
+			r:           7,
+			err:         ErrSingular,
+		},
+		{
+			description: "zero",
+			firstRow:    big.NewInt(0),
+			r:           7,
+			err:         ErrSingular,
+		},
+		{
+			description: "row too large",
+			firstRow:    big.NewInt(128),
+			r:           7,
+			err:         ErrDimensionMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		inverse, err := InvertCirculant(test.firstRow, test.r)
+
+		assert.Equalf(t, test.err, err, test.description)
+
+		if test.err != nil {
+			continue
+		}
+
+		matrix := NewQuasiCyclic(1, 1, test.r)
+		matrix.SetBlock(0, 0, test.firstRow)
+
+		inverseMatrix := NewQuasiCyclic(1, 1, test.r)
+		inverseMatrix.SetBlock(0, 0, inverse)
+
+		expected, err := matrix.ToF2().Inverse()
+		assert.Nilf(t, err, test.description)
+		assert.Truef(t, expected.IsEqual(inverseMatrix.ToF2()), test.description)
+	}
+
+	// verify the inverse of random circulants, if they are invertible
+	for seed := int64(0); seed < 10; seed++ {
+		row := randomQuasiCyclic(1, 1, 101, seed).Blocks[0][0]
+
+		inverse, err := InvertCirculant(row, 101)
+
+		if err == ErrSingular {
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Zero(t, big.NewInt(1).Cmp(polyMulMod(row, inverse, 101)))
+	}
+}
@@ -0,0 +1,317 @@
+package gomatrix
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/bits"
+)
+
+// seededReader is a deterministic source of random bytes
+//
+// The bytes are the SHA-256 hashes of the seed and a counter, so the output
+// is reproducible and still indistinguishable from random bytes as long as
+// the seed is secret.
+type seededReader struct {
+	seed    [sha256.Size]byte
+	counter uint64
+	buffer  []byte
+}
+
+// NewSeededReader creates a deterministic source of random bytes
+//
+// The same seed always results in the same bytes, which makes experiments
+// and tests reproducible. For key generation, the seed needs to be secret and
+// contain enough entropy.
+//
+// @param []byte seed The seed of the source
+//
+// @return io.Reader
+func NewSeededReader(seed []byte) io.Reader {
+	return &seededReader{
+		seed: sha256.Sum256(seed),
+	}
+}
+
+// Read fills p with the next bytes of the source
+//
+// @param []byte p The buffer to fill
+//
+// @return int, error
+func (s *seededReader) Read(p []byte) (int, error) {
+	// initialize the count of read bytes
+	n := 0
+
+	for n < len(p) {
+		// if the buffer is empty...
+		if len(s.buffer) == 0 {
+			// ...hash the seed and the counter into the next block
+			block := make([]byte, sha256.Size+8)
+			copy(block, s.seed[:])
+			binary.LittleEndian.PutUint64(block[sha256.Size:], s.counter)
+
+			hash := sha256.Sum256(block)
+			s.buffer = hash[:]
+			s.counter++
+		}
+
+		// copy the buffered bytes
+		copied := copy(p[n:], s.buffer)
+		s.buffer = s.buffer[copied:]
+		n += copied
+	}
+
+	return n, nil
+}
+
+// randomSource returns the given source or crypto/rand if it is nil
+//
+// @param io.Reader rng The source of random bytes
+//
+// @return io.Reader
+func randomSource(rng io.Reader) io.Reader {
+	if rng == nil {
+		return rand.Reader
+	}
+
+	return rng
+}
+
+// randomInt returns a uniformly distributed number between 0 and n-1
+//
+// Random values are masked to the bit length of n-1 and rejected until they
+// are smaller than n, so the result has no modulo bias.
+//
+// @param io.Reader rng The source of random bytes
+// @param int       n   The upper bound, which needs to be positive
+//
+// @return int, error
+func randomInt(rng io.Reader, n int) (int, error) {
+	// create the mask with the bit length of the largest result
+	mask := uint64(1)<<uint(bits.Len64(uint64(n-1))) - 1
+
+	// initialize the buffer for the random bytes
+	buffer := make([]byte, 8)
+
+	for {
+		// read the random bytes
+		if _, err := io.ReadFull(rng, buffer); err != nil {
+			return 0, err
+		}
+
+		// get the masked value
+		value := binary.LittleEndian.Uint64(buffer) & mask
+
+		// if the value is in range...
+		if value < uint64(n) {
+			// ...return it
+			return int(value), nil
+		}
+	}
+}
+
+// RandomPermutation creates a uniformly distributed random permutation
+//
+// The permutation is created with the Fisher-Yates shuffle. If n is
+// negative, ErrInvalidDimensions is returned. If rng is nil, crypto/rand is
+// used.
+//
+// @param int       n   The count of indice
+// @param io.Reader rng The source of random bytes
+//
+// @return Permutation, error
+func RandomPermutation(n int, rng io.Reader) (Permutation, error) {
+	// verify the count of indice
+	if n < 0 {
+		return nil, ErrInvalidDimensions
+	}
+
+	// get the source of random bytes
+	rng = randomSource(rng)
+
+	// initialize the permutation
+	p := NewPermutation(n)
+
+	// swap each index with a random destination index
+	for i := 0; i < n-1; i++ {
+		// get the destination index
+		j, err := randomInt(rng, n-i)
+		if err != nil {
+			return nil, err
+		}
+
+		// swap the indice
+		p.Swap(i, i+j)
+	}
+
+	return p, nil
+}
+
+// RandomF2 creates a uniformly distributed random matrix
+//
+// If n or m is negative, ErrInvalidDimensions is returned. If rng is nil,
+// crypto/rand is used.
+//
+// @param int       n   The count of rows
+// @param int       m   The count of columns
+// @param io.Reader rng The source of random bytes
+//
+// @return *F2, error
+func RandomF2(n, m int, rng io.Reader) (*F2, error) {
+	// verify the dimensions
+	if n < 0 || m < 0 {
+		return nil, ErrInvalidDimensions
+	}
+
+	// get the source of random bytes
+	rng = randomSource(rng)
+
+	// create the matrix
+	f := NewF2(n, m)
+
+	// initialize the buffer for the random bytes of a row
+	buffer := make([]byte, (m+7)/8)
+
+	// iterate through the rows
+	for _, row := range f.Rows {
+		// read the random bytes
+		if _, err := io.ReadFull(rng, buffer); err != nil {
+			return nil, err
+		}
+
+		// remove the bits beyond the last column
+		row.SetBytes(buffer).Rsh(row, uint(8*len(buffer)-m))
+	}
+
+	return f, nil
+}
+
+// PermuteColsFrom permutes the columns of the matrix randomly
+//
+// This function works like PermuteColsCompact, but the uniformly distributed
+// permutation is created from the given source of random bytes. If rng is
+// nil, crypto/rand is used.
+//
+// @param io.Reader rng The source of random bytes
+//
+// @return Permutation, error
+func (f *F2) PermuteColsFrom(rng io.Reader) (Permutation, error) {
+	// create the permutation
+	p, err := RandomPermutation(f.M, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply it to the columns
+	p.ApplyToCols(f)
+
+	return p, nil
+}
+
+// RandomInvertible creates a uniformly distributed random invertible matrix
+//
+// If rng is nil, crypto/rand is used.
+//
+// @param int       n   The count of rows and columns
+// @param io.Reader rng The source of random bytes
+//
+// @return *F2, error
+func RandomInvertible(n int, rng io.Reader) (*F2, error) {
+	// create the matrix and drop the inverse
+	f, _, err := RandomInvertibleWithInverse(n, rng)
+
+	return f, err
+}
+
+// RandomInvertibleWithInverse creates a uniformly distributed random
+// invertible matrix and its inverse
+//
+// Random matrices are drawn until the gaussian elimination of [f|I] results
+// in the identity matrix, so every invertible matrix has the same
+// probability. On average, less than four matrices are drawn. If rng is nil,
+// crypto/rand is used.
+//
+// @param int       n   The count of rows and columns
+// @param io.Reader rng The source of random bytes
+//
+// @return *F2, *F2, error
+func RandomInvertibleWithInverse(n int, rng io.Reader) (*F2, *F2, error) {
+	// the empty matrix is its own inverse
+	if n == 0 {
+		return NewF2(0, 0), NewF2(0, 0), nil
+	}
+
+	for {
+		// draw a random matrix
+		f, err := RandomF2(n, n, rng)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// try to invert it
+		inverse, err := f.Inverse()
+
+		// if the matrix is singular...
+		if err == ErrSingular {
+			// ...draw the next one
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return f, inverse, nil
+	}
+}
+
+// RandomWithRank creates a uniformly distributed random matrix with rank r
+//
+// The matrix is the product S*D*T of the random invertible matrices S and T
+// and the n x m identity matrix D, where all rows from r on are set to 0.
+// If r is negative or greater than n or m, ErrInvalidRank is returned. If
+// rng is nil, crypto/rand is used.
+//
+// @param int       n   The count of rows
+// @param int       m   The count of columns
+// @param int       r   The rank of the matrix
+// @param io.Reader rng The source of random bytes
+//
+// @return *F2, error
+func RandomWithRank(n, m, r int, rng io.Reader) (*F2, error) {
+	// verify the rank
+	if r < 0 || r > n || r > m {
+		return nil, ErrInvalidRank
+	}
+
+	// the matrix with rank 0 is the zero matrix
+	if r == 0 {
+		return NewF2(n, m), nil
+	}
+
+	// get the source of random bytes
+	rng = randomSource(rng)
+
+	// create the random row and column operations
+	s, err := RandomInvertible(n, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := RandomInvertible(m, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the identity matrix with rank r
+	d := NewF2(n, m).SetToIdentity()
+
+	// remove the 1 of the rows from r on
+	for i := r; i < n; i++ {
+		d.Rows[i].SetInt64(0)
+	}
+
+	// apply the row and column operations
+	return s.MulMatrix(d).MulMatrix(t), nil
+}
@@ -0,0 +1,240 @@
+package gomatrix
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeededReader(t *testing.T) {
+	bufferA := make([]byte, 100)
+	bufferB := make([]byte, 100)
+	bufferC := make([]byte, 100)
+
+	_, err := io.ReadFull(NewSeededReader([]byte("seed")), bufferA)
+	assert.Nil(t, err)
+
+	// read the same bytes in smaller chunks
+	reader := NewSeededReader([]byte("seed"))
+	for i := 0; i < len(bufferB); i += 7 {
+		end := i + 7
+		if end > len(bufferB) {
+			end = len(bufferB)
+		}
+
+		_, err = io.ReadFull(reader, bufferB[i:end])
+		assert.Nil(t, err)
+	}
+
+	_, err = io.ReadFull(NewSeededReader([]byte("other seed")), bufferC)
+	assert.Nil(t, err)
+
+	assert.Equal(t, bufferA, bufferB)
+	assert.NotEqual(t, bufferA, bufferC)
+}
+
+func TestRandomInt(t *testing.T) {
+	rng := NewSeededReader([]byte("random int"))
+	counts := make([]int, 6)
+
+	for i := 0; i < 6000; i++ {
+		value, err := randomInt(rng, 6)
+
+		assert.Nil(t, err)
+		assert.True(t, value >= 0 && value < 6)
+
+		counts[value]++
+	}
+
+	for _, count := range counts {
+		assert.InDelta(t, 1000, count, 150)
+	}
+
+	_, err := randomInt(bytes.NewReader(nil), 6)
+	assert.NotNil(t, err)
+}
+
+func TestRandomPermutation(t *testing.T) {
+	p, err := RandomPermutation(50, NewSeededReader([]byte("permutation")))
+	assert.Nil(t, err)
+	assert.True(t, p.IsValid())
+
+	q, err := RandomPermutation(50, NewSeededReader([]byte("permutation")))
+	assert.Nil(t, err)
+	assert.Equal(t, p, q)
+
+	p, err = RandomPermutation(50, nil)
+	assert.Nil(t, err)
+	assert.True(t, p.IsValid())
+
+	_, err = RandomPermutation(50, bytes.NewReader([]byte{1, 2, 3}))
+	assert.NotNil(t, err)
+
+	_, err = RandomPermutation(-1, nil)
+	assert.Equal(t, ErrInvalidDimensions, err)
+
+	_, err = RandomF2(-1, 3, nil)
+	assert.Equal(t, ErrInvalidDimensions, err)
+
+	_, err = RandomInvertible(-2, nil)
+	assert.Equal(t, ErrInvalidDimensions, err)
+}
+
+func TestRandomF2(t *testing.T) {
+	tests := []struct {
+		description string
+		n           int
+		m           int
+	}{
+		{
+			description: "square matrix",
+			n:           10,
+			m:           10,
+		},
+		{
+			description: "wide matrix",
+			n:           3,
+			m:           131,
+		},
+	}
+
+	for _, test := range tests {
+		matrixA, err := RandomF2(test.n, test.m, NewSeededReader([]byte(test.description)))
+		assert.Nilf(t, err, test.description)
+
+		matrixB, err := RandomF2(test.n, test.m, NewSeededReader([]byte(test.description)))
+		assert.Nilf(t, err, test.description)
+
+		assert.Truef(t, matrixA.IsEqual(matrixB), test.description)
+
+		for _, row := range matrixA.Rows {
+			assert.Truef(t, row.BitLen() <= test.m, test.description)
+		}
+
+		matrixC, err := RandomF2(test.n, test.m, nil)
+		assert.Nilf(t, err, test.description)
+		assert.Falsef(t, matrixA.IsEqual(matrixC), test.description)
+	}
+
+	_, err := RandomF2(2, 10, bytes.NewReader([]byte{1}))
+	assert.NotNil(t, err)
+}
+
+func TestPermuteColsFrom(t *testing.T) {
+	matrix := randomF2(5, 40, 1)
+	saved := NewF2(5, 40).Set(matrix.Rows)
+
+	p, err := matrix.PermuteColsFrom(NewSeededReader([]byte("cols")))
+	assert.Nil(t, err)
+
+	expected, err := RandomPermutation(40, NewSeededReader([]byte("cols")))
+	assert.Nil(t, err)
+
+	assert.Equal(t, expected, p)
+	assert.True(t, saved.MulMatrix(p.ToMatrix()).IsEqual(matrix))
+}
+
+func TestPackedPermuteColsFrom(t *testing.T) {
+	matrix := randomF2(5, 70, 2)
+	packed := matrix.ToPacked()
+
+	p, err := packed.PermuteColsFrom(NewSeededReader([]byte("packed cols")))
+	assert.Nil(t, err)
+
+	q, err := matrix.PermuteColsFrom(NewSeededReader([]byte("packed cols")))
+	assert.Nil(t, err)
+
+	assert.Equal(t, q, p)
+	assert.True(t, packed.ToF2().IsEqual(matrix))
+}
+
+func TestRandomInvertible(t *testing.T) {
+	for _, n := range []int{1, 2, 10, 70} {
+		rng := NewSeededReader([]byte{byte(n)})
+
+		matrix, inverse, err := RandomInvertibleWithInverse(n, rng)
+		assert.Nilf(t, err, "n = %d", n)
+
+		identity := NewF2(n, n).SetToIdentity()
+		product := NewF2(n, n).Set(matrix.Rows).MulMatrix(inverse)
+
+		assert.Truef(t, product.IsEqual(identity), "n = %d", n)
+
+		matrix, err = RandomInvertible(n, nil)
+		assert.Nilf(t, err, "n = %d", n)
+		assert.Equalf(t, n, matrix.Rank(), "n = %d", n)
+	}
+
+	matrix, inverse, err := RandomInvertibleWithInverse(0, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, matrix.N)
+	assert.Equal(t, 0, inverse.N)
+
+	_, err = RandomInvertible(10, bytes.NewReader([]byte{1}))
+	assert.NotNil(t, err)
+}
+
+func TestRandomWithRank(t *testing.T) {
+	tests := []struct {
+		description string
+		n           int
+		m           int
+		r           int
+		err         error
+	}{
+		{
+			description: "full rank square matrix",
+			n:           8,
+			m:           8,
+			r:           8,
+		},
+		{
+			description: "tall matrix",
+			n:           20,
+			m:           7,
+			r:           4,
+		},
+		{
+			description: "wide matrix",
+			n:           5,
+			m:           90,
+			r:           5,
+		},
+		{
+			description: "zero matrix",
+			n:           4,
+			m:           6,
+			r:           0,
+		},
+		{
+			description: "rank too large",
+			n:           4,
+			m:           6,
+			r:           5,
+			err:         ErrInvalidRank,
+		},
+		{
+			description: "negative rank",
+			n:           4,
+			m:           6,
+			r:           -1,
+			err:         ErrInvalidRank,
+		},
+	}
+
+	for _, test := range tests {
+		matrix, err := RandomWithRank(test.n, test.m, test.r, NewSeededReader([]byte(test.description)))
+
+		assert.Equalf(t, test.err, err, test.description)
+
+		if test.err != nil {
+			continue
+		}
+
+		assert.Equalf(t, test.n, matrix.N, test.description)
+		assert.Equalf(t, test.m, matrix.M, test.description)
+		assert.Equalf(t, test.r, matrix.Rank(), test.description)
+	}
+}
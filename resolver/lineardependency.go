@@ -1,11 +1,15 @@
 package resolver
 
 import (
-	"fmt"
+	"errors"
 
 	"git.noc.ruhr-uni-bochum.de/danieljankowski/gomatrix"
 )
 
+// ErrUnresolvableDependency is returned if no row and column can be swapped
+// into the position of the missing pivot bit
+var ErrUnresolvableDependency = errors.New("cannot resolve dependency")
+
 // LinearDependenciesInGauss tries to resolve linear dependencies in the gaussian
 // elimination.
 //
@@ -21,16 +25,56 @@ func LinearDependenciesInGauss(
 	stopCol int,
 	pivotBit int,
 ) (*gomatrix.F2, *gomatrix.F2, error) {
-	// resolve the linear dependency
-	gaussMatrix, permutationMatrix, err := resolveWithOptimizedAlgorithm(
+	// resolve the linear dependency while swapping the columns of the
+	// permutation matrix
+	gaussMatrix, err := resolveLinearDependency(
+		f,
+		gaussMatrix,
+		startRow,
+		startCol,
+		pivotBit,
+		func(i, j int) {
+			permutationMatrix.SwapCols(i, j)
+		},
+	)
+
+	// if an error occured...
+	if err != nil {
+		// ...return it
+		return nil, nil, err
+	}
+
+	// return success
+	return gaussMatrix, permutationMatrix, nil
+}
+
+// LinearDependenciesInGaussWithPermutation tries to resolve linear
+// dependencies in the gaussian elimination.
+//
+// This function works like LinearDependenciesInGauss, but it records the
+// column swaps in a permutation. It is used as linearCheck-function of
+// PartialGaussianWithPermutation.
+func LinearDependenciesInGaussWithPermutation(
+	f *gomatrix.F2,
+	gaussMatrix *gomatrix.F2,
+	permutation gomatrix.Permutation,
+	startRow int,
+	startCol int,
+	stopRow int,
+	stopCol int,
+	pivotBit int,
+) (*gomatrix.F2, gomatrix.Permutation, error) {
+	// resolve the linear dependency while swapping the entries of the
+	// permutation
+	gaussMatrix, err := resolveLinearDependency(
 		f,
 		gaussMatrix,
-		permutationMatrix,
 		startRow,
 		startCol,
-		stopRow,
-		stopCol,
 		pivotBit,
+		func(i, j int) {
+			permutation.Swap(i, j)
+		},
 	)
 
 	// if an error occured...
@@ -39,6 +83,38 @@ func LinearDependenciesInGauss(
 		return nil, nil, err
 	}
 
+	// return success
+	return gaussMatrix, permutation, nil
+}
+
+// resolveLinearDependency resolves the linear dependency and applies the
+// previous row operations on the new row
+//
+// The swapCols callback is called for each swap of columns in f.
+func resolveLinearDependency(
+	f *gomatrix.F2,
+	gaussMatrix *gomatrix.F2,
+	startRow int,
+	startCol int,
+	pivotBit int,
+	swapCols func(int, int),
+) (*gomatrix.F2, error) {
+	// resolve the linear dependency
+	gaussMatrix, err := resolveWithOptimizedAlgorithm(
+		f,
+		gaussMatrix,
+		startRow,
+		startCol,
+		pivotBit,
+		swapCols,
+	)
+
+	// if an error occured...
+	if err != nil {
+		// ...return it
+		return nil, err
+	}
+
 	// apply the previous operations on the new row, with iterating through
 	// the columns 'til the pivot bit is reached
 	for i := startCol; i < pivotBit; i++ {
@@ -61,7 +137,7 @@ func LinearDependenciesInGauss(
 	}
 
 	// return success
-	return gaussMatrix, permutationMatrix, nil
+	return gaussMatrix, nil
 }
 
 // resolveWithOptimizedAlgorithm tries to resolve the dependency with finding
@@ -70,13 +146,11 @@ func LinearDependenciesInGauss(
 func resolveWithOptimizedAlgorithm(
 	f *gomatrix.F2,
 	gaussMatrix *gomatrix.F2,
-	permutationMatrix *gomatrix.F2,
 	startRow int,
 	startCol int,
-	stopRow int,
-	stopCol int,
 	pivotBit int,
-) (*gomatrix.F2, *gomatrix.F2, error) {
+	swapCols func(int, int),
+) (*gomatrix.F2, error) {
 	// iterate through the rows
 	for rowIndex := 0; rowIndex < f.N; rowIndex++ {
 		// if the rowindex points on to the already processed rows...
@@ -106,14 +180,15 @@ func resolveWithOptimizedAlgorithm(
 			f.SwapRows(rowIndex, startRow+pivotBit-startCol)
 			f.SwapCols(colIndex, pivotBit)
 
-			// swap the rows in the permutation matrix
+			// swap the rows in the transformation matrix and record the
+			// swap of the columns
 			gaussMatrix.SwapRows(rowIndex, startRow+pivotBit-startCol)
-			permutationMatrix.SwapCols(colIndex, pivotBit)
+			swapCols(colIndex, pivotBit)
 
 			// return success
-			return gaussMatrix, permutationMatrix, nil
+			return gaussMatrix, nil
 		}
 	}
 
-	return nil, nil, fmt.Errorf("cannot resolve dependency")
+	return nil, ErrUnresolvableDependency
 }
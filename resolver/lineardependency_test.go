@@ -153,3 +153,71 @@ func TestLinearDependenciesInGauss(t *testing.T) {
 		assert.Truef(t, test.expectedResult.IsEqual(test.matrix), test.description)
 	}
 }
+
+func TestLinearDependenciesInGaussWithPermutation(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *gomatrix.F2
+		startRow    int
+		startCol    int
+		stopRow     int
+		stopCol     int
+	}{
+		{
+			description: "simple swap and postprocessing of the row",
+			matrix: gomatrix.NewF2(4, 4).Set([]*big.Int{
+				big.NewInt(10),
+				big.NewInt(13),
+				big.NewInt(0),
+				big.NewInt(14),
+			}),
+			startRow: 0,
+			startCol: 1,
+			stopRow:  2,
+			stopCol:  3,
+		},
+		{
+			description: "simple swap of columns",
+			matrix: gomatrix.NewF2(4, 4).Set([]*big.Int{
+				big.NewInt(5),
+				big.NewInt(14),
+				big.NewInt(8),
+				big.NewInt(8),
+			}),
+			startRow: 0,
+			startCol: 0,
+			stopRow:  2,
+			stopCol:  2,
+		},
+	}
+
+	for _, test := range tests {
+		matrix := gomatrix.NewF2(test.matrix.N, test.matrix.M).Set(test.matrix.Rows)
+
+		expectedGauss, expectedPermutation, expectedErr := test.matrix.PartialGaussianWithLinearChecking(
+			test.startRow,
+			test.startCol,
+			test.stopRow,
+			test.stopCol,
+			LinearDependenciesInGauss,
+		)
+
+		gaussMatrix, permutation, err := matrix.PartialGaussianWithPermutation(
+			test.startRow,
+			test.startCol,
+			test.stopRow,
+			test.stopCol,
+			LinearDependenciesInGaussWithPermutation,
+		)
+
+		assert.Equalf(t, expectedErr, err, test.description)
+
+		if err != nil {
+			continue
+		}
+
+		assert.Truef(t, test.matrix.IsEqual(matrix), test.description)
+		assert.Truef(t, expectedGauss.IsEqual(gaussMatrix), test.description)
+		assert.Truef(t, expectedPermutation.IsEqual(permutation.ToMatrix()), test.description)
+	}
+}
@@ -0,0 +1,106 @@
+package resolver
+
+import (
+	"git.noc.ruhr-uni-bochum.de/danieljankowski/gomatrix"
+)
+
+// PackedLinearDependenciesInGauss tries to resolve linear dependencies in the
+// gaussian elimination of a word-packed matrix.
+//
+// This function is the counterpart of LinearDependenciesInGauss for the
+// function PackedF2.PartialGaussianWithLinearChecking.
+func PackedLinearDependenciesInGauss(
+	f *gomatrix.PackedF2,
+	gaussMatrix *gomatrix.PackedF2,
+	permutationMatrix *gomatrix.PackedF2,
+	startRow int,
+	startCol int,
+	stopRow int,
+	stopCol int,
+	pivotBit int,
+) (*gomatrix.PackedF2, *gomatrix.PackedF2, error) {
+	// resolve the linear dependency
+	gaussMatrix, permutationMatrix, err := resolvePackedWithOptimizedAlgorithm(
+		f,
+		gaussMatrix,
+		permutationMatrix,
+		startRow,
+		startCol,
+		pivotBit,
+	)
+
+	// if an error occured...
+	if err != nil {
+		// ...return it
+		return nil, nil, err
+	}
+
+	// apply the previous operations on the new row, with iterating through
+	// the columns 'til the pivot bit is reached
+	for i := startCol; i < pivotBit; i++ {
+		// if the column is zero...
+		if bit, _ := f.At(pivotBit-startCol, i); bit == 0 {
+			// ...skip to the next column
+			continue
+		}
+
+		// remove the 1 with a xor operation with the relating row
+		f.XorRow(startRow+pivotBit-startCol, startRow+i-startCol)
+		gaussMatrix.XorRow(startRow+pivotBit-startCol, startRow+i-startCol)
+	}
+
+	// return success
+	return gaussMatrix, permutationMatrix, nil
+}
+
+// resolvePackedWithOptimizedAlgorithm tries to resolve the dependency with
+// finding an appropriate value that can be swapped right into the correct
+// position without destroying the already processed rows and columns.
+func resolvePackedWithOptimizedAlgorithm(
+	f *gomatrix.PackedF2,
+	gaussMatrix *gomatrix.PackedF2,
+	permutationMatrix *gomatrix.PackedF2,
+	startRow int,
+	startCol int,
+	pivotBit int,
+) (*gomatrix.PackedF2, *gomatrix.PackedF2, error) {
+	// iterate through the rows
+	for rowIndex := 0; rowIndex < f.N; rowIndex++ {
+		// if the rowindex points on to the already processed rows...
+		if rowIndex >= startRow && rowIndex <= startRow+pivotBit-startCol {
+			// ...skip it
+			continue
+		}
+
+		// iterate through the columns
+		for colIndex := 0; colIndex < f.M; colIndex++ {
+			// if the colindex points on to the already processed rows...
+			if colIndex >= startCol && colIndex < pivotBit {
+				// ...skip it
+				continue
+			}
+
+			// get the value at the current index
+			bit, err := f.At(rowIndex, colIndex)
+
+			// if an error occured or the bit is 0...
+			if err != nil || bit == 0 {
+				// ...skip it
+				continue
+			}
+
+			// swap the value into the right place
+			f.SwapRows(rowIndex, startRow+pivotBit-startCol)
+			f.SwapCols(colIndex, pivotBit)
+
+			// swap the rows in the permutation matrix
+			gaussMatrix.SwapRows(rowIndex, startRow+pivotBit-startCol)
+			permutationMatrix.SwapCols(colIndex, pivotBit)
+
+			// return success
+			return gaussMatrix, permutationMatrix, nil
+		}
+	}
+
+	return nil, nil, ErrUnresolvableDependency
+}
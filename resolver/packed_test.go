@@ -0,0 +1,102 @@
+package resolver
+
+import (
+	"math/big"
+	"testing"
+
+	"git.noc.ruhr-uni-bochum.de/danieljankowski/gomatrix"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackedLinearDependenciesInGauss(t *testing.T) {
+	tests := []struct {
+		description   string
+		matrix        *gomatrix.F2
+		startRow      int
+		startCol      int
+		stopRow       int
+		stopCol       int
+		pivotBit      int
+		expectedError bool
+	}{
+		{
+			description: "simple swap and postprocessing of the row",
+			matrix: gomatrix.NewF2(4, 4).Set([]*big.Int{
+				big.NewInt(10),
+				big.NewInt(13),
+				big.NewInt(0),
+				big.NewInt(14),
+			}),
+			startRow: 0,
+			startCol: 1,
+			stopRow:  2,
+			stopCol:  3,
+			pivotBit: 3,
+		},
+		{
+			description: "simple swap of columns + continue",
+			matrix: gomatrix.NewF2(4, 4).Set([]*big.Int{
+				big.NewInt(5),
+				big.NewInt(14),
+				big.NewInt(8),
+				big.NewInt(8),
+			}),
+			startRow: 0,
+			startCol: 0,
+			stopRow:  2,
+			stopCol:  2,
+			pivotBit: 2,
+		},
+		{
+			description: "no way to resolve the dependency",
+			matrix: gomatrix.NewF2(4, 4).Set([]*big.Int{
+				big.NewInt(10),
+				big.NewInt(13),
+				big.NewInt(0),
+				big.NewInt(0),
+			}),
+			startRow:      0,
+			startCol:      1,
+			stopRow:       2,
+			stopCol:       3,
+			pivotBit:      3,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		packed := test.matrix.ToPacked()
+
+		_, _, expectedErr := LinearDependenciesInGauss(
+			test.matrix,
+			gomatrix.NewF2(test.matrix.N, test.matrix.N).SetToIdentity(),
+			gomatrix.NewF2(test.matrix.M, test.matrix.M).SetToIdentity(),
+			test.startRow,
+			test.startCol,
+			test.stopRow,
+			test.stopCol,
+			test.pivotBit,
+		)
+
+		_, _, err := PackedLinearDependenciesInGauss(
+			packed,
+			gomatrix.NewPackedF2(packed.N, packed.N).SetToIdentity(),
+			gomatrix.NewPackedF2(packed.M, packed.M).SetToIdentity(),
+			test.startRow,
+			test.startCol,
+			test.stopRow,
+			test.stopCol,
+			test.pivotBit,
+		)
+
+		assert.Equalf(t, test.expectedError, err != nil, test.description)
+		assert.Equalf(t, expectedErr != nil, err != nil, test.description)
+
+		if err != nil {
+			continue
+		}
+
+		assert.Truef(t, test.matrix.IsEqual(packed.ToF2()), test.description)
+	}
+}
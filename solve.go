@@ -0,0 +1,167 @@
+package gomatrix
+
+import (
+	"math/bits"
+)
+
+// maxIterableBits is the maximum count of free bits of a solution space that
+// can be enumerated with a SolutionIterator
+const maxIterableBits = 63
+
+// Solution describes all solutions X of a linear system A*X = B
+//
+// The solutions form the affine space Particular + K, where each column of K
+// is an arbitrary linear combination of the rows of Kernel.
+type Solution struct {
+	// Particular is one solution of the system with A.M rows and B.M columns
+	Particular *F2
+
+	// Kernel contains a basis of the right kernel of A in its rows
+	Kernel *F2
+}
+
+// Solve solves the linear system a*x = b
+//
+// b can be a column vector with a.N rows or a matrix with multiple columns,
+// where each column is a separate right-hand side. The system is solved with
+// the gaussian elimination of the matrix [a|b]. If the system has no solution,
+// ErrInconsistent is returned.
+//
+// @param *F2 a The coefficient matrix
+// @param *F2 b The right-hand side
+//
+// @return *Solution, error
+func Solve(a, b *F2) (*Solution, error) {
+	// the right-hand side needs a value for each equation
+	if a.N != b.N {
+		return nil, ErrDimensionMismatch
+	}
+
+	// create the matrix [a|b]
+	augmented := NewF2(a.N, a.M+b.M)
+
+	// iterate through the rows
+	for i, row := range a.Rows {
+		// append the right-hand side to the row
+		augmented.Rows[i].Lsh(b.Rows[i], uint(a.M))
+		augmented.Rows[i].Or(augmented.Rows[i], row)
+	}
+
+	// search the pivot bits only in the coefficient part, while the row
+	// operations are applied to the right-hand side as well
+	pivotCols := augmented.reduce(0, 0, a.N, a.M)
+
+	// the rows without a pivot bit are 0 in the coefficient part...
+	for i := len(pivotCols); i < a.N; i++ {
+		// ...so they need to be 0 in the right-hand side too
+		if augmented.Rows[i].Sign() != 0 {
+			return nil, ErrInconsistent
+		}
+	}
+
+	// create the particular solution with all free variables set to 0
+	particular := NewF2(a.M, b.M)
+
+	// each pivot variable equals the right-hand side of its row
+	for i, col := range pivotCols {
+		particular.Rows[col].Rsh(augmented.Rows[i], uint(a.M))
+	}
+
+	// return the solution space
+	return &Solution{
+		Particular: particular,
+		Kernel:     kernelFromReduced(augmented, pivotCols, a.M),
+	}, nil
+}
+
+// Iterator returns an iterator over all solutions of the system
+//
+// The solution space contains 2^(Kernel.N*Particular.M) solutions. If this
+// exceeds 2^63, ErrTooManySolutions is returned.
+//
+// @return *SolutionIterator, error
+func (s *Solution) Iterator() (*SolutionIterator, error) {
+	// get the count of free bits in the solution space
+	freeBits := s.Kernel.N * s.Particular.M
+
+	// verify that the solutions can be counted
+	if freeBits > maxIterableBits {
+		return nil, ErrTooManySolutions
+	}
+
+	// return the iterator, starting at the particular solution
+	return &SolutionIterator{
+		kernel:  s.Kernel,
+		current: s.Particular.Clone(),
+		total:   uint64(1) << uint(freeBits),
+	}, nil
+}
+
+// SolutionIterator enumerates all solutions of a linear system
+//
+// The solutions are enumerated in gray code order, so that each solution
+// differs from the previous one by a single kernel vector in a single column.
+//
+//	it, err := solution.Iterator()
+//	for it.Next() {
+//		x := it.Solution()
+//	}
+type SolutionIterator struct {
+	kernel  *F2
+	current *F2
+	counter uint64
+	total   uint64
+}
+
+// Next advances the iterator to the next solution
+//
+// @return bool false if all solutions were enumerated
+func (it *SolutionIterator) Next() bool {
+	// if all solutions were enumerated...
+	if it.counter >= it.total {
+		// ...stop
+		return false
+	}
+
+	// the first solution is the particular solution
+	if it.counter > 0 {
+		// get the free bit that changes in the gray code
+		freeBit := bits.TrailingZeros64(it.counter)
+
+		// add the relating kernel vector to the relating column
+		it.addKernelVector(freeBit%it.kernel.N, freeBit/it.kernel.N)
+	}
+
+	// continue with the next solution
+	it.counter++
+
+	return true
+}
+
+// Solution returns a copy of the current solution
+//
+// @return *F2
+func (it *SolutionIterator) Solution() *F2 {
+	return it.current.Clone()
+}
+
+// addKernelVector adds the kernel vector to the column of the current solution
+//
+// @param int vector The index of the kernel vector
+// @param int col    The index of the column
+func (it *SolutionIterator) addKernelVector(vector, col int) {
+	// get the kernel vector
+	v := it.kernel.Rows[vector]
+
+	// iterate through the rows of the solution
+	for i, row := range it.current.Rows {
+		// if the kernel vector does not change this row...
+		if v.Bit(i) == uint(0) {
+			// ...skip it
+			continue
+		}
+
+		// flip the bit in the column
+		row.SetBit(row, col, row.Bit(col)^1)
+	}
+}
@@ -0,0 +1,151 @@
+package gomatrix
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		description      string
+		a                *F2
+		b                *F2
+		expectedError    error
+		expectedSolution *Solution
+	}{
+		{
+			description: "invertible system",
+			a:           NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(2)}),
+			b:           NewF2(3, 1).Set([]*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(1)}),
+			expectedSolution: &Solution{
+				Particular: NewF2(3, 1).Set([]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(0)}),
+				Kernel:     NewF2(0, 3),
+			},
+		},
+		{
+			description: "underdetermined system",
+			a:           NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(6)}),
+			b:           NewF2(3, 1).Set([]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(0)}),
+			expectedSolution: &Solution{
+				Particular: NewF2(3, 1).Set([]*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(0)}),
+				Kernel:     NewF2(1, 3).Set([]*big.Int{big.NewInt(7)}),
+			},
+		},
+		{
+			description: "multiple right-hand sides",
+			a:           NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(2)}),
+			b:           NewF2(3, 3).SetToIdentity(),
+			expectedSolution: &Solution{
+				Particular: NewF2(3, 3).Set([]*big.Int{big.NewInt(6), big.NewInt(4), big.NewInt(7)}),
+				Kernel:     NewF2(0, 3),
+			},
+		},
+		{
+			description:   "inconsistent system",
+			a:             NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(6)}),
+			b:             NewF2(3, 1).Set([]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(1)}),
+			expectedError: ErrInconsistent,
+		},
+		{
+			description:   "dimension mismatch",
+			a:             NewF2(3, 3),
+			b:             NewF2(2, 1),
+			expectedError: ErrDimensionMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		solution, err := Solve(test.a, test.b)
+
+		assert.Equalf(t, test.expectedError, err, test.description)
+
+		if err != nil {
+			continue
+		}
+
+		assert.Truef(t, test.expectedSolution.Particular.IsEqual(solution.Particular), test.description)
+		assert.Truef(t, test.expectedSolution.Kernel.IsEqual(solution.Kernel), test.description)
+
+		product := NewF2(test.a.N, test.a.M).Set(test.a.Rows).MulMatrix(solution.Particular)
+		assert.Truef(t, test.b.IsEqual(product), test.description)
+	}
+}
+
+func TestSolveRandom(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		a := randomF2(20, 30, seed)
+		x := randomF2(30, 2, seed+100)
+		b := NewF2(a.N, a.M).Set(a.Rows).MulMatrix(x)
+
+		solution, err := Solve(a, b)
+
+		assert.Nil(t, err)
+		assert.True(t, b.IsEqual(NewF2(a.N, a.M).Set(a.Rows).MulMatrix(solution.Particular)))
+		assert.True(t, a.Kernel().IsEqual(solution.Kernel))
+	}
+}
+
+func TestSolutionIterator(t *testing.T) {
+	tests := []struct {
+		description   string
+		a             *F2
+		b             *F2
+		expectedCount int
+	}{
+		{
+			description:   "unique solution",
+			a:             NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(2)}),
+			b:             NewF2(3, 1).Set([]*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(1)}),
+			expectedCount: 1,
+		},
+		{
+			description:   "wide system",
+			a:             NewF2(2, 5).Set([]*big.Int{big.NewInt(12), big.NewInt(6)}),
+			b:             NewF2(2, 1).Set([]*big.Int{big.NewInt(1), big.NewInt(0)}),
+			expectedCount: 8,
+		},
+		{
+			description:   "multiple right-hand sides",
+			a:             NewF2(2, 3).Set([]*big.Int{big.NewInt(3), big.NewInt(6)}),
+			b:             NewF2(2, 2).Set([]*big.Int{big.NewInt(1), big.NewInt(2)}),
+			expectedCount: 4,
+		},
+	}
+
+	for _, test := range tests {
+		solution, err := Solve(test.a, test.b)
+		assert.Nilf(t, err, test.description)
+
+		it, err := solution.Iterator()
+		assert.Nilf(t, err, test.description)
+
+		seen := map[string]bool{}
+
+		for it.Next() {
+			x := it.Solution()
+
+			product := NewF2(test.a.N, test.a.M).Set(test.a.Rows).MulMatrix(x)
+			assert.Truef(t, test.b.IsEqual(product), test.description)
+
+			key := ""
+			for _, row := range x.Rows {
+				key += row.String() + ","
+			}
+
+			seen[key] = true
+		}
+
+		assert.Equalf(t, test.expectedCount, len(seen), test.description)
+		assert.Falsef(t, it.Next(), test.description)
+	}
+}
+
+func TestSolutionIteratorTooLarge(t *testing.T) {
+	solution, err := Solve(NewF2(1, 70), NewF2(1, 1))
+	assert.Nil(t, err)
+
+	_, err = solution.Iterator()
+	assert.Equal(t, ErrTooManySolutions, err)
+}
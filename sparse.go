@@ -0,0 +1,455 @@
+package gomatrix
+
+import (
+	"math/big"
+	"sort"
+)
+
+// SparseF2 represents a sparse matrix with entries that contains 0 or 1
+//
+// In contrast to F2, each row only stores the sorted indice of the columns
+// that contain a 1. This layout needs a lot less memory for matrices with a
+// few ones per row, like the parity-check matrices of LDPC and MDPC codes.
+type SparseF2 struct {
+	N    int
+	M    int
+	Rows [][]int
+}
+
+// SparseEliminationProfile describes the result of the sparse gaussian
+// elimination
+type SparseEliminationProfile struct {
+	// Rank is the count of linearly independent rows
+	Rank int
+
+	// PivotCols contains the columns with a pivot bit in increasing order
+	PivotCols []int
+
+	// FreeCols contains the columns without a pivot bit in increasing order
+	FreeCols []int
+
+	// Weight is the count of ones before the elimination
+	Weight int
+
+	// FillIn is the count of ones that were created by row additions
+	FillIn int
+
+	// FinalWeight is the count of ones after the elimination
+	FinalWeight int
+}
+
+// NewSparseF2 creates a new sparse matrix in F_2
+//
+// @param int n The count of rows
+// @param int m The count of columns
+//
+// @return *SparseF2
+func NewSparseF2(n, m int) *SparseF2 {
+	return &SparseF2{
+		N:    n,
+		M:    m,
+		Rows: make([][]int, n),
+	}
+}
+
+// ToSparse converts the matrix into the sparse representation
+//
+// @return *SparseF2
+func (f *F2) ToSparse() *SparseF2 {
+	// create the sparse matrix
+	s := NewSparseF2(f.N, f.M)
+
+	// iterate through the rows
+	for i, row := range f.Rows {
+		// collect the set bits in increasing order
+		for j := 0; j < row.BitLen(); j++ {
+			if row.Bit(j) == 1 {
+				s.Rows[i] = append(s.Rows[i], j)
+			}
+		}
+	}
+
+	return s
+}
+
+// ToF2 converts the matrix into the dense representation
+//
+// @return *F2
+func (s *SparseF2) ToF2() *F2 {
+	// create the dense matrix
+	f := NewF2(s.N, s.M)
+
+	// set the bits of each row
+	for i, row := range s.Rows {
+		for _, j := range row {
+			f.Rows[i].SetBit(f.Rows[i], j, 1)
+		}
+	}
+
+	return f
+}
+
+// Set sets data from the data array
+//
+// Each row of data contains the columns of the ones in strictly increasing
+// order. The rows are copied into the matrix.
+//
+// @param [][]int data The data to insert into the matrix
+//
+// @return *SparseF2|nil
+func (s *SparseF2) Set(data [][]int) *SparseF2 {
+	// if the size is different...
+	if len(data) != s.N {
+		// ...return an error
+		return nil
+	}
+
+	// verify all rows before modifying the matrix
+	for _, datum := range data {
+		for k, j := range datum {
+			// the columns need to be in the matrix and strictly increasing
+			if j < 0 || j >= s.M || (k > 0 && datum[k-1] >= j) {
+				return nil
+			}
+		}
+	}
+
+	// copy the rows
+	for i, datum := range data {
+		s.Rows[i] = append([]int(nil), datum...)
+	}
+
+	return s
+}
+
+// At returns the value at index i, j
+//
+// @param int i The row index
+// @param int j The column index
+//
+// @return int, error|nil
+func (s *SparseF2) At(i, j int) (int, error) {
+	// check if the indice are in the matrix
+	if i < 0 || j < 0 || i >= s.N || j >= s.M {
+		return -1, ErrIndexOutOfRange
+	}
+
+	// search the column in the row
+	if containsCol(s.Rows[i], j) {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+// RowWeight returns the count of ones in the row at index i
+//
+// @param int i The row index
+//
+// @return int
+func (s *SparseF2) RowWeight(i int) int {
+	return len(s.Rows[i])
+}
+
+// Weight returns the count of ones in the matrix
+//
+// @return int
+func (s *SparseF2) Weight() int {
+	// initialize the weight
+	weight := 0
+
+	// sum up the row weights
+	for _, row := range s.Rows {
+		weight += len(row)
+	}
+
+	return weight
+}
+
+// IsEqual checks the equality of the matrix objects
+//
+// @param *SparseF2 m The matrix to compare with
+//
+// @return bool
+func (s *SparseF2) IsEqual(m *SparseF2) bool {
+	// compare the sizes
+	if s.N != m.N || s.M != m.M {
+		return false
+	}
+
+	// iterate through the rows
+	for i, row := range s.Rows {
+		// compare the weight of the rows
+		if len(row) != len(m.Rows[i]) {
+			return false
+		}
+
+		// compare the columns
+		for k, j := range row {
+			if j != m.Rows[i][k] {
+				return false
+			}
+		}
+	}
+
+	// size and values are equal
+	return true
+}
+
+// AddMatrix adds the matrix m to s
+//
+// @param *SparseF2 m The matrix to add
+//
+// @return *SparseF2|nil
+func (s *SparseF2) AddMatrix(m *SparseF2) *SparseF2 {
+	// if the dimensions are different...
+	if s.N != m.N || s.M != m.M {
+		// ...return an error
+		return nil
+	}
+
+	// add the rows
+	for i, row := range m.Rows {
+		s.Rows[i], _ = xorCols(s.Rows[i], row)
+	}
+
+	return s
+}
+
+// MulMatrix multiplies the sparse matrix s with the dense matrix m
+//
+// Each row of the result is the sum of the rows of m that are selected by the
+// ones in the relating row of s. s is not modified.
+//
+// @param *F2 m The matrix to multiply with
+//
+// @return *F2|nil
+func (s *SparseF2) MulMatrix(m *F2) *F2 {
+	// if the dimensions do not fit for a multiplication...
+	if s.M != m.N {
+		// ...return an error
+		return nil
+	}
+
+	// create the result matrix
+	result := NewF2(s.N, m.M)
+
+	// process blocks of rows concurrently
+	parallelRows(s.N, func(start, stop int) {
+		for i := start; i < stop; i++ {
+			// add the selected rows of m
+			for _, j := range s.Rows[i] {
+				result.Rows[i].Xor(result.Rows[i], m.Rows[j])
+			}
+		}
+	})
+
+	return result
+}
+
+// MulVector multiplies the matrix with the column vector v
+//
+// The bit j of v is the entry j of the vector, so the bit i of the result is
+// the parity of the bits of v that are selected by the row i.
+//
+// @param *big.Int v The vector to multiply with
+//
+// @return *big.Int
+func (s *SparseF2) MulVector(v *big.Int) *big.Int {
+	// initialize the result
+	result := big.NewInt(0)
+
+	// iterate through the rows
+	for i, row := range s.Rows {
+		// calculate the parity of the selected bits
+		parity := uint(0)
+		for _, j := range row {
+			parity ^= v.Bit(j)
+		}
+
+		// set the bit of the row
+		result.SetBit(result, i, parity)
+	}
+
+	return result
+}
+
+// T transposes matrix s
+func (s *SparseF2) T() *SparseF2 {
+	// create the result matrix
+	result := NewSparseF2(s.M, s.N)
+
+	// iterate through the rows in increasing order, so that the transposed
+	// rows are sorted
+	for i, row := range s.Rows {
+		for _, j := range row {
+			result.Rows[j] = append(result.Rows[j], i)
+		}
+	}
+
+	// save the result matrix
+	s.N, s.M, s.Rows = result.N, result.M, result.Rows
+
+	return s
+}
+
+// GetSubMatrix gets the submatrix with the stop boundaries excluded
+//
+// @param int startRow The first row to include
+// @param int startCol The first column to include
+// @param int stopRow  The row after the last row to include
+// @param int stopCol  The column after the last column to include
+//
+// @return *SparseF2|nil
+func (s *SparseF2) GetSubMatrix(startRow, startCol, stopRow, stopCol int) *SparseF2 {
+	// verify the boundaries
+	if startRow < 0 || startCol < 0 || stopRow > s.N || stopCol > s.M ||
+		startRow > stopRow || startCol > stopCol {
+		return nil
+	}
+
+	// create the output matrix
+	output := NewSparseF2(stopRow-startRow, stopCol-startCol)
+
+	// iterate through the given rows
+	for i := startRow; i < stopRow; i++ {
+		// get the range of the row that is inside of the columns
+		row := s.Rows[i]
+		first := sort.SearchInts(row, startCol)
+		last := sort.SearchInts(row, stopCol)
+
+		// shift the columns to the left
+		for _, j := range row[first:last] {
+			output.Rows[i-startRow] = append(output.Rows[i-startRow], j-startCol)
+		}
+	}
+
+	return output
+}
+
+// GaussianElimination converts the matrix to the reduced row echelon form
+//
+// The result is the same as the result of F2.GaussianElimination. In order
+// to keep the rows sparse, the row with the lowest weight is used as pivot
+// row for each column. The created fill-in is reported in the profile.
+//
+// @return *SparseEliminationProfile
+func (s *SparseF2) GaussianElimination() *SparseEliminationProfile {
+	// initialize the profile
+	profile := &SparseEliminationProfile{
+		PivotCols: []int{},
+		Weight:    s.Weight(),
+	}
+
+	for profile.Rank < s.N {
+		// all columns before the pivot column are already eliminated in the
+		// remaining rows, so the next pivot column is the smallest leading
+		// column of the remaining rows
+		pivotCol := -1
+		pivotRow := -1
+
+		// iterate through the remaining rows
+		for i := profile.Rank; i < s.N; i++ {
+			row := s.Rows[i]
+
+			// if the row is 0...
+			if len(row) == 0 {
+				// ...skip it
+				continue
+			}
+
+			// prefer the smallest column and then the lowest weight
+			if pivotRow == -1 || row[0] < pivotCol ||
+				(row[0] == pivotCol && len(row) < len(s.Rows[pivotRow])) {
+				pivotCol = row[0]
+				pivotRow = i
+			}
+		}
+
+		// if all remaining rows are 0...
+		if pivotRow == -1 {
+			// ...the elimination is done
+			break
+		}
+
+		// swap the pivot row into place
+		s.Rows[profile.Rank], s.Rows[pivotRow] = s.Rows[pivotRow], s.Rows[profile.Rank]
+		pivot := s.Rows[profile.Rank]
+
+		// eliminate the pivot bit from all other rows
+		for i, row := range s.Rows {
+			// skip the pivot row and the rows without the bit
+			if i == profile.Rank || !containsCol(row, pivotCol) {
+				continue
+			}
+
+			// add the pivot row and count the created ones
+			var created int
+			s.Rows[i], created = xorCols(row, pivot)
+			profile.FillIn += created
+		}
+
+		// save the pivot column
+		profile.PivotCols = append(profile.PivotCols, pivotCol)
+		profile.Rank++
+	}
+
+	// complete the profile
+	profile.FreeCols = freeCols(profile.PivotCols, s.M)
+	profile.FinalWeight = s.Weight()
+
+	return profile
+}
+
+// containsCol checks if the sorted row contains the column j
+//
+// @param []int row The sorted columns of the row
+// @param int   j   The column to search
+//
+// @return bool
+func containsCol(row []int, j int) bool {
+	// search the position of the column
+	k := sort.SearchInts(row, j)
+
+	return k < len(row) && row[k] == j
+}
+
+// xorCols adds the sorted rows a and b
+//
+// The result contains all columns that are in exactly one of the rows. The
+// rows are not modified.
+//
+// @param []int a The first row
+// @param []int b The second row
+//
+// @return []int, int The sum and the count of columns of b that are not in a
+func xorCols(a, b []int) ([]int, int) {
+	// initialize the result
+	result := make([]int, 0, len(a)+len(b))
+	created := 0
+
+	// merge the rows
+	k, l := 0, 0
+	for k < len(a) && l < len(b) {
+		switch {
+		case a[k] < b[l]:
+			result = append(result, a[k])
+			k++
+		case a[k] > b[l]:
+			result = append(result, b[l])
+			created++
+			l++
+		default:
+			// the column cancels out
+			k++
+			l++
+		}
+	}
+
+	// append the remaining columns
+	result = append(result, a[k:]...)
+	result = append(result, b[l:]...)
+	created += len(b) - l
+
+	return result, created
+}
@@ -0,0 +1,224 @@
+package gomatrix
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// randomSparseF2 creates a reproducible random sparse matrix for the tests
+func randomSparseF2(n, m, weight int, seed int64) *SparseF2 {
+	rng := rand.New(rand.NewSource(seed))
+
+	matrix := NewF2(n, m)
+
+	for i := 0; i < n; i++ {
+		for k := 0; k < weight; k++ {
+			matrix.Rows[i].SetBit(matrix.Rows[i], rng.Intn(m), 1)
+		}
+	}
+
+	return matrix.ToSparse()
+}
+
+func TestSparseConversion(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *F2
+	}{
+		{
+			description: "3x3 matrix",
+			matrix:      NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(0)}),
+		},
+		{
+			description: "large random matrix",
+			matrix:      randomF2(17, 200, 1),
+		},
+		{
+			description: "empty matrix",
+			matrix:      NewF2(0, 5),
+		},
+	}
+
+	for _, test := range tests {
+		sparse := test.matrix.ToSparse()
+
+		assert.Equalf(t, test.matrix.N, sparse.N, test.description)
+		assert.Equalf(t, test.matrix.M, sparse.M, test.description)
+		assert.Truef(t, test.matrix.IsEqual(sparse.ToF2()), test.description)
+	}
+
+	sparse := NewF2(3, 3).Set([]*big.Int{big.NewInt(5), big.NewInt(3), big.NewInt(0)}).ToSparse()
+	assert.Equal(t, [][]int{{0, 2}, {0, 1}, nil}, sparse.Rows)
+	assert.Equal(t, 2, sparse.RowWeight(0))
+	assert.Equal(t, 4, sparse.Weight())
+}
+
+func TestSparseSet(t *testing.T) {
+	tests := []struct {
+		description string
+		data        [][]int
+		valid       bool
+	}{
+		{
+			description: "valid rows",
+			data:        [][]int{{0, 3}, {}},
+			valid:       true,
+		},
+		{
+			description: "wrong count of rows",
+			data:        [][]int{{0, 3}},
+		},
+		{
+			description: "column out of range",
+			data:        [][]int{{0, 4}, {}},
+		},
+		{
+			description: "unsorted row",
+			data:        [][]int{{3, 0}, {}},
+		},
+		{
+			description: "duplicate column",
+			data:        [][]int{{1, 1}, {}},
+		},
+	}
+
+	for _, test := range tests {
+		result := NewSparseF2(2, 4).Set(test.data)
+
+		assert.Equalf(t, test.valid, result != nil, test.description)
+	}
+}
+
+func TestSparseAt(t *testing.T) {
+	sparse := NewSparseF2(2, 4).Set([][]int{{0, 3}, {2}})
+
+	tests := []struct {
+		description string
+		i           int
+		j           int
+		expected    int
+		err         error
+	}{
+		{
+			description: "set bit",
+			i:           0,
+			j:           3,
+			expected:    1,
+		},
+		{
+			description: "unset bit",
+			i:           1,
+			j:           3,
+			expected:    0,
+		},
+		{
+			description: "row out of range",
+			i:           2,
+			j:           0,
+			expected:    -1,
+			err:         ErrIndexOutOfRange,
+		},
+		{
+			description: "negative column",
+			i:           0,
+			j:           -1,
+			expected:    -1,
+			err:         ErrIndexOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		value, err := sparse.At(test.i, test.j)
+
+		assert.Equalf(t, test.expected, value, test.description)
+		assert.Equalf(t, test.err, err, test.description)
+	}
+}
+
+func TestSparseAddMatrix(t *testing.T) {
+	a := randomSparseF2(20, 300, 5, 1)
+	b := randomSparseF2(20, 300, 5, 2)
+
+	expected := a.ToF2().AddMatrix(b.ToF2())
+
+	assert.True(t, expected.IsEqual(a.AddMatrix(b).ToF2()))
+	assert.Nil(t, a.AddMatrix(NewSparseF2(20, 299)))
+}
+
+func TestSparseMulMatrix(t *testing.T) {
+	a := randomSparseF2(30, 200, 4, 3)
+	b := randomF2(200, 70, 4)
+
+	expected := a.ToF2().MulMatrix(b)
+
+	assert.True(t, expected.IsEqual(a.MulMatrix(b)))
+	assert.Nil(t, a.MulMatrix(NewF2(199, 70)))
+}
+
+func TestSparseMulVector(t *testing.T) {
+	a := randomSparseF2(30, 200, 4, 5)
+	v := randomF2(1, 200, 6)
+
+	expected := NewF2(1, 200).Set(v.Rows).T()
+	expected = a.ToF2().MulMatrix(expected).T()
+
+	assert.Zero(t, expected.Rows[0].Cmp(a.MulVector(v.Rows[0])))
+}
+
+func TestSparseT(t *testing.T) {
+	a := randomSparseF2(30, 200, 4, 7)
+	expected := a.ToF2().T()
+
+	assert.True(t, expected.IsEqual(a.T().ToF2()))
+	assert.Equal(t, 200, a.N)
+	assert.Equal(t, 30, a.M)
+}
+
+func TestSparseGetSubMatrix(t *testing.T) {
+	a := randomSparseF2(30, 200, 8, 8)
+
+	expected := a.ToF2().GetSubMatrix(3, 50, 20, 150)
+
+	assert.True(t, expected.IsEqual(a.GetSubMatrix(3, 50, 20, 150).ToF2()))
+	assert.Nil(t, a.GetSubMatrix(3, 50, 31, 150))
+	assert.Nil(t, a.GetSubMatrix(3, 50, 2, 150))
+}
+
+func TestSparseGaussianElimination(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *SparseF2
+	}{
+		{
+			description: "sparse wide matrix",
+			matrix:      randomSparseF2(40, 400, 3, 9),
+		},
+		{
+			description: "rank deficient tall matrix",
+			matrix:      randomSparseF2(60, 30, 2, 10),
+		},
+		{
+			description: "zero matrix",
+			matrix:      NewSparseF2(5, 5),
+		},
+	}
+
+	for _, test := range tests {
+		dense := test.matrix.ToF2()
+		expected := dense.ReducedRowEchelonForm()
+		weight := test.matrix.Weight()
+
+		profile := test.matrix.GaussianElimination()
+
+		assert.Truef(t, dense.IsEqual(test.matrix.ToF2()), test.description)
+		assert.Equalf(t, expected.Rank, profile.Rank, test.description)
+		assert.Equalf(t, expected.PivotCols, profile.PivotCols, test.description)
+		assert.Equalf(t, expected.FreeCols, profile.FreeCols, test.description)
+		assert.Equalf(t, weight, profile.Weight, test.description)
+		assert.Equalf(t, test.matrix.Weight(), profile.FinalWeight, test.description)
+		assert.Truef(t, profile.FinalWeight <= profile.Weight+profile.FillIn, test.description)
+	}
+}
@@ -0,0 +1,169 @@
+package gomatrix
+
+// DefaultStrassenCutoff is the dimension below which MulMatrixStrassen uses
+// the method of four russians, if no cutoff is given
+const DefaultStrassenCutoff = 2048
+
+// MulMatrixStrassen multiplies matrix f with matrix m with the recursive
+// Strassen-Winograd algorithm
+//
+// The matrices are split into 2x2 blocks, which are multiplied with seven
+// instead of eight block multiplications. Odd dimensions are padded with 0.
+// As soon as any dimension of a block is not larger than cutoff, the blocks
+// are multiplied with the method of four russians. If cutoff is not
+// positive, DefaultStrassenCutoff is used. The result is the same as the
+// result of MulMatrix: it is stored in f and returned, or nil is returned if
+// the matrices cannot be multiplied.
+//
+// @param *F2 m      The matrix that is used for the multiplication
+// @param int cutoff The dimension below which the recursion stops
+//
+// @return *F2
+func (f *F2) MulMatrixStrassen(m *F2, cutoff int) *F2 {
+	// multiply the matrices and drop the error
+	result, err := f.MulMatrixStrassenChecked(m, cutoff)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+// MulMatrixStrassenChecked multiplies matrix f with matrix m with the
+// recursive Strassen-Winograd algorithm
+//
+// This function works like MulMatrixStrassen, but it returns
+// ErrDimensionMismatch instead of nil if the matrices cannot be multiplied.
+//
+// @param *F2 m      The matrix that is used for the multiplication
+// @param int cutoff The dimension below which the recursion stops
+//
+// @return *F2, error
+func (f *F2) MulMatrixStrassenChecked(m *F2, cutoff int) (*F2, error) {
+	// if the dimensions do not fit for a multiplication...
+	if f.M != m.N {
+		// ...return an error
+		return nil, ErrDimensionMismatch
+	}
+
+	// use the default cutoff if none is given
+	if cutoff <= 0 {
+		cutoff = DefaultStrassenCutoff
+	}
+
+	// multiply the matrices
+	result := strassenWinograd(f, m, cutoff)
+
+	// save the result matrix in f
+	f.N = result.N
+	f.M = result.M
+	f.Rows = result.Rows
+
+	// return the result
+	return result, nil
+}
+
+// strassenWinograd multiplies the matrices a and b recursively
+//
+// @param *F2 a      The left matrix
+// @param *F2 b      The right matrix
+// @param int cutoff The dimension below which the recursion stops
+//
+// @return *F2 The new result matrix
+func strassenWinograd(a, b *F2, cutoff int) *F2 {
+	// if any dimension is small enough...
+	if a.N <= cutoff || a.M <= cutoff || b.M <= cutoff {
+		// ...use the base case multiplication
+		return a.mulM4RM(b)
+	}
+
+	// get the size of the blocks, rounded up
+	n, k, m := (a.N+1)/2, (a.M+1)/2, (b.M+1)/2
+
+	// pad the matrices to even dimensions
+	pa := padded(a, 2*n, 2*k)
+	pb := padded(b, 2*k, 2*m)
+
+	// split the matrices into blocks
+	a11, a12 := pa.GetSubMatrix(0, 0, n, k), pa.GetSubMatrix(0, k, n, 2*k)
+	a21, a22 := pa.GetSubMatrix(n, 0, 2*n, k), pa.GetSubMatrix(n, k, 2*n, 2*k)
+	b11, b12 := pb.GetSubMatrix(0, 0, k, m), pb.GetSubMatrix(0, m, k, 2*m)
+	b21, b22 := pb.GetSubMatrix(k, 0, 2*k, m), pb.GetSubMatrix(k, m, 2*k, 2*m)
+
+	// compute the sums of the blocks of a. In F_2, subtraction is addition.
+	s1 := sum(a21, a22)
+	s2 := sum(s1, a11)
+	s3 := sum(a11, a21)
+	s4 := sum(a12, s2)
+
+	// compute the sums of the blocks of b
+	t1 := sum(b12, b11)
+	t2 := sum(b22, t1)
+	t3 := sum(b22, b12)
+	t4 := sum(t2, b21)
+
+	// compute the seven products
+	p1 := strassenWinograd(a11, b11, cutoff)
+	p2 := strassenWinograd(a12, b21, cutoff)
+	p3 := strassenWinograd(s4, b22, cutoff)
+	p4 := strassenWinograd(a22, t4, cutoff)
+	p5 := strassenWinograd(s1, t1, cutoff)
+	p6 := strassenWinograd(s2, t2, cutoff)
+	p7 := strassenWinograd(s3, t3, cutoff)
+
+	// combine the products
+	u2 := sum(p1, p6)
+	u3 := sum(u2, p7)
+	u4 := sum(u2, p5)
+
+	// create the padded result from the blocks
+	result := NewF2(2*n, 2*m)
+	result.SetSubMatrix(sum(p1, p2), 0, 0)
+	result.SetSubMatrix(sum(u4, p3), 0, m)
+	result.SetSubMatrix(sum(u3, p4), n, 0)
+	result.SetSubMatrix(sum(u3, p5), n, m)
+
+	// remove the padding
+	return result.GetSubMatrix(0, 0, a.N, b.M)
+}
+
+// padded returns the matrix with additional zero rows and columns
+//
+// If the matrix already has the given dimensions, it is returned unchanged.
+//
+// @param *F2 f The matrix to pad
+// @param int n The count of rows of the padded matrix
+// @param int m The count of columns of the padded matrix
+//
+// @return *F2
+func padded(f *F2, n, m int) *F2 {
+	// if no padding is needed...
+	if f.N == n && f.M == m {
+		// ...return the matrix
+		return f
+	}
+
+	// create the padded matrix
+	result := NewF2(n, m)
+
+	// copy the rows
+	for i, row := range f.Rows {
+		result.Rows[i].Set(row)
+	}
+
+	return result
+}
+
+// sum returns the sum of two matrices of the same size without modifying
+// them
+//
+// @param *F2 a The first matrix
+// @param *F2 b The second matrix
+//
+// @return *F2
+func sum(a, b *F2) *F2 {
+	// the blocks always have the same size, so no error can occur
+	result, _ := Add(a, b)
+
+	return result
+}
@@ -0,0 +1,64 @@
+package gomatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMulMatrixStrassen(t *testing.T) {
+	tests := []struct {
+		description string
+		matrixA     *F2
+		matrixB     *F2
+		cutoff      int
+		expectedNil bool
+	}{
+		{
+			description: "power of two dimensions",
+			matrixA:     randomF2(64, 64, 1),
+			matrixB:     randomF2(64, 64, 2),
+			cutoff:      8,
+		},
+		{
+			description: "odd dimensions",
+			matrixA:     randomF2(45, 77, 3),
+			matrixB:     randomF2(77, 51, 4),
+			cutoff:      5,
+		},
+		{
+			description: "tall and wide matrices",
+			matrixA:     randomF2(100, 20, 5),
+			matrixB:     randomF2(20, 90, 6),
+			cutoff:      3,
+		},
+		{
+			description: "default cutoff",
+			matrixA:     randomF2(30, 30, 7),
+			matrixB:     randomF2(30, 30, 8),
+			cutoff:      0,
+		},
+		{
+			description: "invalid multiplication",
+			matrixA:     randomF2(3, 4, 9),
+			matrixB:     randomF2(3, 4, 10),
+			cutoff:      1,
+			expectedNil: true,
+		},
+	}
+
+	for _, test := range tests {
+		expected := test.matrixA.mulNaive(test.matrixB)
+
+		result := test.matrixA.MulMatrixStrassen(test.matrixB, test.cutoff)
+
+		assert.Equalf(t, test.expectedNil, result == nil, test.description)
+
+		if result == nil {
+			continue
+		}
+
+		assert.Truef(t, expected.IsEqual(result), test.description)
+		assert.Truef(t, expected.IsEqual(test.matrixA), test.description)
+	}
+}
@@ -0,0 +1,248 @@
+package gomatrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// jsonF2 is the JSON representation of F2
+type jsonF2 struct {
+	N    int               `json:"n"`
+	M    int               `json:"m"`
+	Rows []json.RawMessage `json:"rows"`
+}
+
+// MarshalText encodes the matrix as text
+//
+// The first line contains the dimensions as "NxM". Each following line
+// contains one row as a string of M characters '0' and '1', starting with
+// the column 0. Each line ends with a newline, e.g. "2x3\n101\n011\n". This
+// implements encoding.TextMarshaler.
+//
+// @return []byte, error
+func (f *F2) MarshalText() ([]byte, error) {
+	// initialize the buffer
+	var buffer bytes.Buffer
+
+	// write the dimensions
+	fmt.Fprintf(&buffer, "%dx%d\n", f.N, f.M)
+
+	// write the rows
+	for _, row := range f.Rows {
+		buffer.WriteString(bitString(row, f.M))
+		buffer.WriteByte('\n')
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalText decodes the matrix from the text of MarshalText
+//
+// Lines may end with "\r\n" and the newline after the last row is optional.
+// The matrix is only modified if the text could be decoded. This implements
+// encoding.TextUnmarshaler.
+//
+// @param []byte text The encoded matrix
+//
+// @return error
+func (f *F2) UnmarshalText(text []byte) error {
+	// split the lines and remove the optional newline at the end
+	content := strings.Replace(string(text), "\r\n", "\n", -1)
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+
+	// read the dimensions
+	n, m, err := parseDimensions(lines[0])
+	if err != nil {
+		return err
+	}
+
+	// verify the count of rows
+	if len(lines)-1 != n {
+		return ErrInvalidEncoding
+	}
+
+	// decode the rows
+	result := NewF2(n, m)
+	for i := range result.Rows {
+		row, err := parseBitString(lines[i+1], m)
+		if err != nil {
+			return err
+		}
+
+		result.Rows[i] = row
+	}
+
+	// save the matrix
+	f.N, f.M, f.Rows = result.N, result.M, result.Rows
+
+	return nil
+}
+
+// MarshalJSON encodes the matrix as JSON object
+//
+// The object contains the dimensions and the rows as bit strings like in
+// MarshalText, e.g. {"n":2,"m":3,"rows":["101","011"]}. This implements
+// json.Marshaler.
+//
+// @return []byte, error
+func (f *F2) MarshalJSON() ([]byte, error) {
+	// initialize the rows
+	rows := make([]string, len(f.Rows))
+
+	// convert the rows to bit strings
+	for i, row := range f.Rows {
+		rows[i] = bitString(row, f.M)
+	}
+
+	return json.Marshal(struct {
+		N    int      `json:"n"`
+		M    int      `json:"m"`
+		Rows []string `json:"rows"`
+	}{
+		N:    f.N,
+		M:    f.M,
+		Rows: rows,
+	})
+}
+
+// UnmarshalJSON decodes the matrix from the JSON of MarshalJSON
+//
+// For compatibility with the previous encoding, the rows may also be given
+// as numbers, e.g. {"N":2,"M":3,"Rows":[5,6]}. The matrix is only modified
+// if the JSON could be decoded. Like for the other types of encoding/json,
+// null does not modify the matrix. This implements json.Unmarshaler.
+//
+// @param []byte data The encoded matrix
+//
+// @return error
+func (f *F2) UnmarshalJSON(data []byte) error {
+	// null is a no-op
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+
+	// decode the object
+	var decoded jsonF2
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	// verify the dimensions
+	if decoded.N < 0 || decoded.M < 0 || len(decoded.Rows) != decoded.N {
+		return ErrInvalidEncoding
+	}
+
+	// decode the rows
+	result := NewF2(decoded.N, decoded.M)
+	for i, raw := range decoded.Rows {
+		row, err := parseJSONRow(raw, decoded.M)
+		if err != nil {
+			return err
+		}
+
+		result.Rows[i] = row
+	}
+
+	// save the matrix
+	f.N, f.M, f.Rows = result.N, result.M, result.Rows
+
+	return nil
+}
+
+// parseDimensions reads the dimensions of the form "NxM"
+//
+// @param string text The dimensions
+//
+// @return int, int, error
+func parseDimensions(text string) (int, int, error) {
+	// split the count of rows and columns
+	parts := strings.Split(text, "x")
+	if len(parts) != 2 {
+		return 0, 0, ErrInvalidEncoding
+	}
+
+	// convert the counts
+	n, errN := strconv.Atoi(parts[0])
+	m, errM := strconv.Atoi(parts[1])
+	if errN != nil || errM != nil || n < 0 || m < 0 {
+		return 0, 0, ErrInvalidEncoding
+	}
+
+	return n, m, nil
+}
+
+// parseJSONRow decodes a row that is given as bit string or as number
+//
+// @param json.RawMessage raw The encoded row
+// @param int             m   The count of columns
+//
+// @return *big.Int, error
+func parseJSONRow(raw json.RawMessage, m int) (*big.Int, error) {
+	// if the row is a string...
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		// ...it is a bit string
+		return parseBitString(text, m)
+	}
+
+	// otherwise the row needs to be a non-negative number that fits
+	row, ok := big.NewInt(0).SetString(string(raw), 10)
+	if !ok || row.Sign() < 0 || row.BitLen() > m {
+		return nil, ErrInvalidEncoding
+	}
+
+	return row, nil
+}
+
+// bitString converts the row into a string of m characters '0' and '1'
+//
+// The first character is the column 0.
+//
+// @param *big.Int row The row to convert
+// @param int      m   The count of columns
+//
+// @return string
+func bitString(row *big.Int, m int) string {
+	// initialize the characters
+	characters := make([]byte, m)
+
+	// set the character of each column
+	for j := range characters {
+		characters[j] = '0' + byte(row.Bit(j))
+	}
+
+	return string(characters)
+}
+
+// parseBitString converts a string of m characters '0' and '1' into a row
+//
+// @param string text The bit string
+// @param int    m    The count of columns
+//
+// @return *big.Int, error
+func parseBitString(text string, m int) (*big.Int, error) {
+	// verify the length
+	if len(text) != m {
+		return nil, ErrInvalidEncoding
+	}
+
+	// initialize the row
+	row := big.NewInt(0)
+
+	// set the bit of each column
+	for j := 0; j < m; j++ {
+		switch text[j] {
+		case '0':
+		case '1':
+			row.SetBit(row, j, 1)
+		default:
+			return nil, ErrInvalidEncoding
+		}
+	}
+
+	return row, nil
+}
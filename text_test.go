@@ -0,0 +1,190 @@
+package gomatrix
+
+import (
+	"encoding"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// the matrix can be used with the encoding interfaces
+var _ encoding.TextMarshaler = &F2{}
+var _ encoding.TextUnmarshaler = &F2{}
+var _ json.Marshaler = &F2{}
+var _ json.Unmarshaler = &F2{}
+
+func TestMarshalText(t *testing.T) {
+	matrix := NewF2(2, 4).Set([]*big.Int{big.NewInt(5), big.NewInt(6)})
+
+	text, err := matrix.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "2x4\n1010\n0110\n", string(text))
+
+	data, err := json.Marshal(matrix)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"n":2,"m":4,"rows":["1010","0110"]}`, string(data))
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *F2
+	}{
+		{
+			description: "trailing zero columns",
+			matrix:      NewF2(2, 130).Set([]*big.Int{big.NewInt(1), big.NewInt(0)}),
+		},
+		{
+			description: "large random matrix",
+			matrix:      randomF2(17, 203, 1),
+		},
+		{
+			description: "matrix without columns",
+			matrix:      NewF2(3, 0),
+		},
+		{
+			description: "empty matrix",
+			matrix:      NewF2(0, 0),
+		},
+	}
+
+	for _, test := range tests {
+		text, err := test.matrix.MarshalText()
+		assert.Nilf(t, err, test.description)
+
+		result := &F2{}
+		assert.Nilf(t, result.UnmarshalText(text), test.description)
+		assert.Truef(t, test.matrix.IsEqual(result), test.description)
+
+		data, err := json.Marshal(test.matrix)
+		assert.Nilf(t, err, test.description)
+
+		result = &F2{}
+		assert.Nilf(t, json.Unmarshal(data, result), test.description)
+		assert.Truef(t, test.matrix.IsEqual(result), test.description)
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	tests := []struct {
+		description string
+		text        string
+		expected    *F2
+		err         error
+	}{
+		{
+			description: "windows line endings without final newline",
+			text:        "2x3\r\n100\r\n011",
+			expected:    NewF2(2, 3).Set([]*big.Int{big.NewInt(1), big.NewInt(6)}),
+		},
+		{
+			description: "invalid header",
+			text:        "2y3\n100\n011\n",
+			err:         ErrInvalidEncoding,
+		},
+		{
+			description: "negative dimension",
+			text:        "-1x3\n",
+			err:         ErrInvalidEncoding,
+		},
+		{
+			description: "missing row",
+			text:        "2x3\n100\n",
+			err:         ErrInvalidEncoding,
+		},
+		{
+			description: "row too short",
+			text:        "2x3\n100\n01\n",
+			err:         ErrInvalidEncoding,
+		},
+		{
+			description: "invalid character",
+			text:        "2x3\n100\n021\n",
+			err:         ErrInvalidEncoding,
+		},
+	}
+
+	for _, test := range tests {
+		matrix := NewF2(1, 1)
+
+		err := matrix.UnmarshalText([]byte(test.text))
+		assert.Equalf(t, test.err, err, test.description)
+
+		if test.err != nil {
+			// the matrix is not modified on errors
+			assert.Truef(t, matrix.IsEqual(NewF2(1, 1)), test.description)
+			continue
+		}
+
+		assert.Truef(t, matrix.IsEqual(test.expected), test.description)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		description string
+		data        string
+		expected    *F2
+		err         error
+	}{
+		{
+			description: "bit strings",
+			data:        `{"n":2,"m":3,"rows":["100","011"]}`,
+			expected:    NewF2(2, 3).Set([]*big.Int{big.NewInt(1), big.NewInt(6)}),
+		},
+		{
+			description: "legacy numbers",
+			data:        `{"N":2,"M":3,"Rows":[1,6]}`,
+			expected:    NewF2(2, 3).Set([]*big.Int{big.NewInt(1), big.NewInt(6)}),
+		},
+		{
+			description: "large legacy number",
+			data:        `{"N":1,"M":80,"Rows":[604462909807314587353088]}`,
+			expected:    NewF2(1, 80).Set([]*big.Int{big.NewInt(0).Lsh(big.NewInt(1), 79)}),
+		},
+		{
+			description: "number too large",
+			data:        `{"n":1,"m":3,"rows":[8]}`,
+			err:         ErrInvalidEncoding,
+		},
+		{
+			description: "wrong count of rows",
+			data:        `{"n":2,"m":3,"rows":["100"]}`,
+			err:         ErrInvalidEncoding,
+		},
+		{
+			description: "invalid bit string",
+			data:        `{"n":1,"m":3,"rows":["10"]}`,
+			err:         ErrInvalidEncoding,
+		},
+		{
+			description: "null row",
+			data:        `{"n":1,"m":3,"rows":[null]}`,
+			err:         ErrInvalidEncoding,
+		},
+	}
+
+	for _, test := range tests {
+		matrix := NewF2(1, 1)
+
+		err := json.Unmarshal([]byte(test.data), matrix)
+		assert.Equalf(t, test.err, err, test.description)
+
+		if test.err != nil {
+			// the matrix is not modified on errors
+			assert.Truef(t, matrix.IsEqual(NewF2(1, 1)), test.description)
+			continue
+		}
+
+		assert.Truef(t, matrix.IsEqual(test.expected), test.description)
+	}
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"n":"x"}`), NewF2(1, 1)))
+
+	// null does not modify the matrix
+	matrix := NewF2(2, 2).SetToIdentity()
+	assert.Nil(t, matrix.UnmarshalJSON([]byte("null")))
+	assert.True(t, matrix.IsEqual(NewF2(2, 2).SetToIdentity()))
+}
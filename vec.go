@@ -0,0 +1,346 @@
+package gomatrix
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Vec represents a vector with entries that contains 0 or 1
+//
+// The entry i of the vector is stored at bit i of Bits, which is the same
+// layout as a row of F2. All bits from N on are always 0.
+type Vec struct {
+	N    int
+	Bits *big.Int
+}
+
+// NewVec creates a new zero vector in F_2
+//
+// @param int n The length of the vector
+//
+// @return *Vec
+func NewVec(n int) *Vec {
+	return &Vec{
+		N:    n,
+		Bits: big.NewInt(0),
+	}
+}
+
+// NewVecFromBits creates a new vector from the given bits
+//
+// The bits are copied into the vector. If the bits do not fit into the
+// vector, ErrDimensionMismatch is returned.
+//
+// @param int      n    The length of the vector
+// @param *big.Int data The bits of the vector
+//
+// @return *Vec, error
+func NewVecFromBits(n int, data *big.Int) (*Vec, error) {
+	// verify that the bits fit into the vector
+	if data.Sign() < 0 || data.BitLen() > n {
+		return nil, ErrDimensionMismatch
+	}
+
+	// create the vector with a copy of the bits
+	return &Vec{
+		N:    n,
+		Bits: big.NewInt(0).Set(data),
+	}, nil
+}
+
+// Len returns the length of the vector
+//
+// @return int
+func (v *Vec) Len() int {
+	return v.N
+}
+
+// Get returns the entry at index i
+//
+// @param int i The index
+//
+// @return int, error
+func (v *Vec) Get(i int) (int, error) {
+	// check if the index is in the vector
+	if i < 0 || i >= v.N {
+		return -1, ErrIndexOutOfRange
+	}
+
+	return int(v.Bits.Bit(i)), nil
+}
+
+// Set sets the entry at index i
+//
+// Any bit other than 0 sets the entry to 1.
+//
+// @param int i   The index
+// @param int bit The new value
+//
+// @return error
+func (v *Vec) Set(i, bit int) error {
+	// check if the index is in the vector
+	if i < 0 || i >= v.N {
+		return ErrIndexOutOfRange
+	}
+
+	// normalize the value
+	if bit != 0 {
+		bit = 1
+	}
+
+	// set the bit
+	v.Bits.SetBit(v.Bits, i, uint(bit))
+
+	return nil
+}
+
+// IsEqual checks the equality of the vectors
+//
+// @param *Vec w The vector to compare with
+//
+// @return bool
+func (v *Vec) IsEqual(w *Vec) bool {
+	return v.N == w.N && v.Bits.Cmp(w.Bits) == 0
+}
+
+// Xor adds the vector w to v
+//
+// @param *Vec w The vector to add
+//
+// @return *Vec|nil
+func (v *Vec) Xor(w *Vec) *Vec {
+	// if the lengths are different...
+	if v.N != w.N {
+		// ...return an error
+		return nil
+	}
+
+	v.Bits.Xor(v.Bits, w.Bits)
+
+	return v
+}
+
+// And multiplies the vector w entrywise with v
+//
+// @param *Vec w The vector to multiply with
+//
+// @return *Vec|nil
+func (v *Vec) And(w *Vec) *Vec {
+	// if the lengths are different...
+	if v.N != w.N {
+		// ...return an error
+		return nil
+	}
+
+	v.Bits.And(v.Bits, w.Bits)
+
+	return v
+}
+
+// Dot returns the scalar product of the vectors v and w
+//
+// @param *Vec w The second vector
+//
+// @return int, error
+func (v *Vec) Dot(w *Vec) (int, error) {
+	// the vectors need the same length
+	if v.N != w.N {
+		return -1, ErrDimensionMismatch
+	}
+
+	// the scalar product is the parity of the common bits
+	return parity(big.NewInt(0).And(v.Bits, w.Bits)), nil
+}
+
+// Weight returns the count of ones in the vector
+//
+// @return int
+func (v *Vec) Weight() int {
+	// initialize the weight
+	weight := 0
+
+	// count the ones of each word
+	for _, word := range v.Bits.Bits() {
+		weight += bits.OnesCount(uint(word))
+	}
+
+	return weight
+}
+
+// NextSet returns the index of the first 1 from index i on
+//
+// If there is no 1 from index i on, -1 is returned. The ones of the vector
+// can be iterated with:
+//
+//	for i := v.NextSet(0); i >= 0; i = v.NextSet(i + 1) {
+//	}
+//
+// @param int i The first index to check
+//
+// @return int
+func (v *Vec) NextSet(i int) int {
+	// negative indice start at the beginning
+	if i < 0 {
+		i = 0
+	}
+
+	// get the words of the vector
+	words := v.Bits.Bits()
+
+	// get the word of the index and remove the bits before the index
+	w := i / bits.UintSize
+	if w >= len(words) {
+		return -1
+	}
+
+	word := uint(words[w]) >> uint(i%bits.UintSize) << uint(i%bits.UintSize)
+
+	for {
+		// if the word contains a 1...
+		if word != 0 {
+			// ...return its index
+			return w*bits.UintSize + bits.TrailingZeros(word)
+		}
+
+		// continue with the next word
+		w++
+		if w >= len(words) {
+			return -1
+		}
+
+		word = uint(words[w])
+	}
+}
+
+// Support returns the indice of all ones in increasing order
+//
+// @return []int
+func (v *Vec) Support() []int {
+	// initialize the indice
+	support := make([]int, 0, v.Weight())
+
+	// collect the ones
+	for i := v.NextSet(0); i >= 0; i = v.NextSet(i + 1) {
+		support = append(support, i)
+	}
+
+	return support
+}
+
+// RowMatrix converts the vector into a matrix with a single row
+//
+// @return *F2
+func (v *Vec) RowMatrix() *F2 {
+	return NewF2(1, v.N).Set([]*big.Int{v.Bits})
+}
+
+// ColMatrix converts the vector into a matrix with a single column
+//
+// @return *F2
+func (v *Vec) ColMatrix() *F2 {
+	// create the matrix
+	f := NewF2(v.N, 1)
+
+	// set the 1 of each set entry
+	for i := v.NextSet(0); i >= 0; i = v.NextSet(i + 1) {
+		f.Rows[i].SetInt64(1)
+	}
+
+	return f
+}
+
+// RowVec returns a copy of the row at index i as vector
+//
+// @param int i The row index
+//
+// @return *Vec, error
+func (f *F2) RowVec(i int) (*Vec, error) {
+	// check if the index is in the matrix
+	if i < 0 || i >= f.N {
+		return nil, ErrIndexOutOfRange
+	}
+
+	return NewVecFromBits(f.M, f.Rows[i])
+}
+
+// ColVec returns a copy of the column at index i as vector
+//
+// @param int i The column index
+//
+// @return *Vec, error
+func (f *F2) ColVec(i int) (*Vec, error) {
+	// check if the index is in the matrix
+	if i < 0 || i >= f.M {
+		return nil, ErrIndexOutOfRange
+	}
+
+	return NewVecFromBits(f.N, f.GetCol(i))
+}
+
+// MulVec multiplies the matrix with the column vector v
+//
+// The entry i of the result is the scalar product of the row i and v.
+//
+// @param *Vec v The vector to multiply with
+//
+// @return *Vec, error
+func (f *F2) MulVec(v *Vec) (*Vec, error) {
+	// the vector needs an entry for each column
+	if v.N != f.M {
+		return nil, ErrDimensionMismatch
+	}
+
+	// create the result vector
+	result := NewVec(f.N)
+
+	// initialize the buffer for the common bits
+	common := big.NewInt(0)
+
+	// calculate the scalar product of each row
+	for i, row := range f.Rows {
+		result.Bits.SetBit(result.Bits, i, uint(parity(common.And(row, v.Bits))))
+	}
+
+	return result, nil
+}
+
+// VecMul multiplies the row vector v with the matrix
+//
+// The result is the sum of the rows that are selected by the ones of v.
+//
+// @param *Vec v The vector to multiply with
+//
+// @return *Vec, error
+func (f *F2) VecMul(v *Vec) (*Vec, error) {
+	// the vector needs an entry for each row
+	if v.N != f.N {
+		return nil, ErrDimensionMismatch
+	}
+
+	// create the result vector
+	result := NewVec(f.M)
+
+	// add the selected rows
+	for i := v.NextSet(0); i >= 0; i = v.NextSet(i + 1) {
+		result.Bits.Xor(result.Bits, f.Rows[i])
+	}
+
+	return result, nil
+}
+
+// parity returns the parity of the ones in x
+//
+// @param *big.Int x The bits to check
+//
+// @return int
+func parity(x *big.Int) int {
+	// initialize the parity
+	result := 0
+
+	// add the parity of each word
+	for _, word := range x.Bits() {
+		result ^= bits.OnesCount(uint(word)) & 1
+	}
+
+	return result
+}
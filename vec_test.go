@@ -0,0 +1,147 @@
+package gomatrix
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVecFromBits(t *testing.T) {
+	v, err := NewVecFromBits(5, big.NewInt(22))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, v.Len())
+	assert.Equal(t, []int{1, 2, 4}, v.Support())
+
+	_, err = NewVecFromBits(4, big.NewInt(22))
+	assert.Equal(t, ErrDimensionMismatch, err)
+}
+
+func TestVecGetSet(t *testing.T) {
+	v := NewVec(70)
+
+	assert.Nil(t, v.Set(3, 1))
+	assert.Nil(t, v.Set(69, 5))
+	assert.Nil(t, v.Set(3, 0))
+	assert.Equal(t, ErrIndexOutOfRange, v.Set(70, 1))
+	assert.Equal(t, ErrIndexOutOfRange, v.Set(-1, 1))
+
+	tests := []struct {
+		description string
+		i           int
+		expected    int
+		err         error
+	}{
+		{
+			description: "reset bit",
+			i:           3,
+			expected:    0,
+		},
+		{
+			description: "set bit in the second word",
+			i:           69,
+			expected:    1,
+		},
+		{
+			description: "index out of range",
+			i:           70,
+			expected:    -1,
+			err:         ErrIndexOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		value, err := v.Get(test.i)
+
+		assert.Equalf(t, test.expected, value, test.description)
+		assert.Equalf(t, test.err, err, test.description)
+	}
+}
+
+func TestVecArithmetic(t *testing.T) {
+	a, _ := NewVecFromBits(6, big.NewInt(45))
+	b, _ := NewVecFromBits(6, big.NewInt(27))
+
+	dot, err := a.Dot(b)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, dot)
+
+	_, err = a.Dot(NewVec(5))
+	assert.Equal(t, ErrDimensionMismatch, err)
+
+	assert.Equal(t, 4, a.Weight())
+
+	sum, _ := NewVecFromBits(6, big.NewInt(45))
+	assert.Zero(t, big.NewInt(54).Cmp(sum.Xor(b).Bits))
+
+	product, _ := NewVecFromBits(6, big.NewInt(45))
+	assert.Zero(t, big.NewInt(9).Cmp(product.And(b).Bits))
+
+	assert.Nil(t, a.Xor(NewVec(5)))
+	assert.Nil(t, a.And(NewVec(5)))
+	assert.True(t, a.IsEqual(&Vec{N: 6, Bits: big.NewInt(45)}))
+}
+
+func TestVecNextSet(t *testing.T) {
+	v := NewVec(200)
+	for _, i := range []int{0, 63, 64, 130, 199} {
+		v.Set(i, 1)
+	}
+
+	assert.Equal(t, []int{0, 63, 64, 130, 199}, v.Support())
+	assert.Equal(t, 63, v.NextSet(1))
+	assert.Equal(t, 130, v.NextSet(65))
+	assert.Equal(t, -1, v.NextSet(200))
+	assert.Equal(t, 0, v.NextSet(-3))
+	assert.Equal(t, []int{}, NewVec(10).Support())
+}
+
+func TestVecConversion(t *testing.T) {
+	matrix := NewF2(3, 4).Set([]*big.Int{
+		big.NewInt(5),
+		big.NewInt(3),
+		big.NewInt(8),
+	})
+
+	row, err := matrix.RowVec(1)
+	assert.Nil(t, err)
+	assert.True(t, row.RowMatrix().IsEqual(matrix.GetSubMatrix(1, 0, 2, 4)))
+
+	col, err := matrix.ColVec(0)
+	assert.Nil(t, err)
+	assert.True(t, col.ColMatrix().IsEqual(matrix.GetSubMatrix(0, 0, 3, 1)))
+
+	_, err = matrix.RowVec(3)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+
+	_, err = matrix.ColVec(4)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+}
+
+func TestMulVec(t *testing.T) {
+	matrix := randomF2(40, 130, 1)
+	v, _ := NewVecFromBits(130, randomF2(1, 130, 2).Rows[0])
+
+	result, err := matrix.MulVec(v)
+	assert.Nil(t, err)
+
+	expected := NewF2(40, 130).Set(matrix.Rows).MulMatrix(v.ColMatrix())
+	assert.True(t, expected.IsEqual(result.ColMatrix()))
+
+	_, err = matrix.MulVec(NewVec(40))
+	assert.Equal(t, ErrDimensionMismatch, err)
+}
+
+func TestVecMul(t *testing.T) {
+	matrix := randomF2(40, 130, 3)
+	v, _ := NewVecFromBits(40, randomF2(1, 40, 4).Rows[0])
+
+	result, err := matrix.VecMul(v)
+	assert.Nil(t, err)
+
+	expected := v.RowMatrix().MulMatrix(matrix)
+	assert.True(t, expected.IsEqual(result.RowMatrix()))
+
+	_, err = matrix.VecMul(NewVec(130))
+	assert.Equal(t, ErrDimensionMismatch, err)
+}
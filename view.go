@@ -0,0 +1,266 @@
+package gomatrix
+
+// View references a rectangular window of a matrix
+//
+// A view does not copy any data, all reads and writes go directly to the rows
+// of the parent matrix. The indice of the view are relative to the upper left
+// corner of the window. Row operations always modify the complete rows of the
+// parent matrix, like the row operations of the partial gaussian
+// elimination.
+type View struct {
+	N int
+	M int
+
+	parent   *F2
+	startRow int
+	startCol int
+}
+
+// View creates a view of the window with the stop boundaries excluded
+//
+// If the window is not inside of the matrix, ErrIndexOutOfRange is returned.
+//
+// @param int startRow The first row of the window
+// @param int startCol The first column of the window
+// @param int stopRow  The row after the last row of the window
+// @param int stopCol  The column after the last column of the window
+//
+// @return *View, error
+func (f *F2) View(startRow, startCol, stopRow, stopCol int) (*View, error) {
+	// verify the boundaries
+	if startRow < 0 || startCol < 0 || stopRow > f.N || stopCol > f.M ||
+		startRow > stopRow || startCol > stopCol {
+		return nil, ErrIndexOutOfRange
+	}
+
+	return f.view(startRow, startCol, stopRow, stopCol), nil
+}
+
+// view creates a view of the window without verifying the boundaries
+//
+// @param int startRow The first row of the window
+// @param int startCol The first column of the window
+// @param int stopRow  The row after the last row of the window
+// @param int stopCol  The column after the last column of the window
+//
+// @return *View
+func (f *F2) view(startRow, startCol, stopRow, stopCol int) *View {
+	return &View{
+		N:        stopRow - startRow,
+		M:        stopCol - startCol,
+		parent:   f,
+		startRow: startRow,
+		startCol: startCol,
+	}
+}
+
+// At returns the value at index i, j of the window
+//
+// @param int i The row index
+// @param int j The column index
+//
+// @return int, error
+func (v *View) At(i, j int) (int, error) {
+	// check if the indice are in the window
+	if i < 0 || j < 0 || i >= v.N || j >= v.M {
+		return -1, ErrIndexOutOfRange
+	}
+
+	return int(v.bit(i, j)), nil
+}
+
+// XorRow adds the row at index j to the row at index i
+//
+// The complete rows of the parent matrix are added, including the bits
+// outside of the window.
+//
+// @param int i The index of the row that is modified
+// @param int j The index of the row that is added
+//
+// @return error
+func (v *View) XorRow(i, j int) error {
+	// check for input parameters
+	if i < 0 || j < 0 || i >= v.N || j >= v.N {
+		return ErrIndexOutOfRange
+	}
+
+	// add the rows of the parent matrix
+	row := v.parent.Rows[v.startRow+i]
+	row.Xor(row, v.parent.Rows[v.startRow+j])
+
+	return nil
+}
+
+// SwapRows swaps the row at index i with the row at index j
+//
+// The complete rows of the parent matrix are swapped, including the bits
+// outside of the window.
+//
+// @param int i The index of the first row to swap
+// @param int j The index of the second row to swap
+//
+// @return error
+func (v *View) SwapRows(i, j int) error {
+	// check for input parameters
+	if i < 0 || j < 0 || i >= v.N || j >= v.N {
+		return ErrIndexOutOfRange
+	}
+
+	// swap the rows of the parent matrix
+	rows := v.parent.Rows
+	rows[v.startRow+i], rows[v.startRow+j] = rows[v.startRow+j], rows[v.startRow+i]
+
+	return nil
+}
+
+// T transposes the window in place
+//
+// Only the bits inside of the window are moved, so the window needs to be
+// square. Otherwise ErrNotSquare is returned.
+//
+// @return error
+func (v *View) T() error {
+	// only square windows can be transposed in place
+	if v.N != v.M {
+		return ErrNotSquare
+	}
+
+	// swap each bit above the diagonal with the relating bit below it
+	for i := 0; i < v.N; i++ {
+		for j := i + 1; j < v.M; j++ {
+			// get both bits
+			upper := v.bit(i, j)
+			lower := v.bit(j, i)
+
+			// if the bits are equal...
+			if upper == lower {
+				// ...nothing needs to be swapped
+				continue
+			}
+
+			// swap the bits
+			v.setBit(i, j, lower)
+			v.setBit(j, i, upper)
+		}
+	}
+
+	return nil
+}
+
+// GaussianElimination performs a gaussian elimination in the window
+//
+// The pivot bit of the column k is searched in the rows k to N-1 of the
+// window and moved to the row k. Afterwards the pivot rows are added to all
+// other rows of the window with a 1 in their column, so each column with a
+// pivot bit only contains this 1. The columns from N on get no pivot row.
+// Only the rows of the window are modified, but the row operations are
+// applied to the complete rows of the parent matrix.
+//
+// @return error
+func (v *View) GaussianElimination() error {
+	// move the pivot bits to the diagonal
+	v.forwardEliminate()
+
+	// only the rows of the window can be pivot rows
+	pivots := v.M
+	if v.N < pivots {
+		pivots = v.N
+	}
+
+	// iterate backwards through the pivot rows
+	for k := pivots - 1; k >= 0; k-- {
+		// if no pivot bit was found for the column...
+		if v.bit(k, k) == uint(0) {
+			// ...continue with the previous one
+			continue
+		}
+
+		// get the row with the pivot bit
+		pivotRow := v.parent.Rows[v.startRow+k]
+
+		// process all other rows of the window concurrently
+		parallelRows(v.N, func(start, stop int) {
+			for rr := start; rr < stop; rr++ {
+				if rr == k || v.bit(rr, k) == uint(0) {
+					continue
+				}
+
+				// eliminate the 1
+				row := v.parent.Rows[v.startRow+rr]
+				row.Xor(row, pivotRow)
+			}
+		})
+	}
+
+	return nil
+}
+
+// forwardEliminate moves the pivot bit of the column k to the row k of the
+// window and eliminates the bits below it
+//
+// The pivot bits are only searched in the rows of the window, so the columns
+// from N on get no pivot row.
+func (v *View) forwardEliminate() {
+	// iterate through all possible pivot bits
+	for k := 0; k < v.M; k++ {
+		// iterate through the rows
+		for rowCounter := k; rowCounter < v.N; rowCounter++ {
+			// if the pivotbit of this row is 0...
+			if v.bit(rowCounter, k) == uint(0) {
+				// ...check the next row
+				continue
+			}
+
+			// if the row with a valid pivot bit is not the first row...
+			if rowCounter != k {
+				// ...swap it with first one
+				v.SwapRows(k, rowCounter)
+			}
+
+			// get the row with the pivot bit
+			pivotRow := v.parent.Rows[v.startRow+k]
+
+			// process all rows below the pivot row concurrently
+			parallelRows(v.N-k-1, func(start, stop int) {
+				for rr := k + 1 + start; rr < k+1+stop; rr++ {
+					if v.bit(rr, k) == uint(0) {
+						continue
+					}
+
+					// subtract the 1 from all other rows with the pivotBit
+					row := v.parent.Rows[v.startRow+rr]
+					row.Xor(row, pivotRow)
+				}
+			})
+
+			break
+		}
+	}
+}
+
+// ToF2 copies the window into a new matrix
+//
+// @return *F2
+func (v *View) ToF2() *F2 {
+	return v.parent.GetSubMatrix(v.startRow, v.startCol, v.startRow+v.N, v.startCol+v.M)
+}
+
+// bit returns the bit at index i, j of the window without verifying it
+//
+// @param int i The row index
+// @param int j The column index
+//
+// @return uint
+func (v *View) bit(i, j int) uint {
+	return v.parent.Rows[v.startRow+i].Bit(v.startCol + j)
+}
+
+// setBit sets the bit at index i, j of the window without verifying it
+//
+// @param int  i   The row index
+// @param int  j   The column index
+// @param uint bit The new value
+func (v *View) setBit(i, j int, bit uint) {
+	row := v.parent.Rows[v.startRow+i]
+	row.SetBit(row, v.startCol+j, bit)
+}
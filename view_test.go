@@ -0,0 +1,215 @@
+package gomatrix
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestView(t *testing.T) {
+	matrix := NewF2(3, 4).Set([]*big.Int{
+		big.NewInt(10),
+		big.NewInt(7),
+		big.NewInt(4),
+	})
+
+	view, err := matrix.View(1, 1, 3, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, view.N)
+	assert.Equal(t, 3, view.M)
+	assert.True(t, view.ToF2().IsEqual(matrix.GetSubMatrix(1, 1, 3, 4)))
+
+	tests := []struct {
+		description string
+		i           int
+		j           int
+		expected    int
+		err         error
+	}{
+		{
+			description: "set bit",
+			i:           0,
+			j:           1,
+			expected:    1,
+		},
+		{
+			description: "unset bit",
+			i:           1,
+			j:           0,
+			expected:    0,
+		},
+		{
+			description: "row outside of the window",
+			i:           2,
+			j:           0,
+			expected:    -1,
+			err:         ErrIndexOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		value, err := view.At(test.i, test.j)
+
+		assert.Equalf(t, test.expected, value, test.description)
+		assert.Equalf(t, test.err, err, test.description)
+	}
+
+	_, err = matrix.View(1, 1, 4, 4)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+
+	_, err = matrix.View(-1, 0, 2, 2)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+}
+
+func TestViewRowOperations(t *testing.T) {
+	matrix := NewF2(3, 4).Set([]*big.Int{
+		big.NewInt(10),
+		big.NewInt(7),
+		big.NewInt(4),
+	})
+
+	view, _ := matrix.View(1, 1, 3, 3)
+
+	// the complete rows of the parent matrix are modified
+	assert.Nil(t, view.XorRow(0, 1))
+	assert.Nil(t, view.SwapRows(0, 1))
+
+	expected := NewF2(3, 4).Set([]*big.Int{
+		big.NewInt(10),
+		big.NewInt(4),
+		big.NewInt(3),
+	})
+
+	assert.True(t, matrix.IsEqual(expected))
+
+	assert.Equal(t, ErrIndexOutOfRange, view.XorRow(0, 2))
+	assert.Equal(t, ErrIndexOutOfRange, view.SwapRows(-1, 0))
+}
+
+func TestViewT(t *testing.T) {
+	matrix := randomF2(10, 12, 1)
+
+	// transpose the window with a copy of the submatrix
+	subMatrix := matrix.GetSubMatrix(2, 3, 9, 10).T()
+	expected, _ := matrix.Clone().SetSubMatrix(subMatrix, 2, 3)
+
+	view, _ := matrix.View(2, 3, 9, 10)
+	assert.Nil(t, view.T())
+	assert.True(t, matrix.IsEqual(expected))
+
+	view, _ = matrix.View(2, 3, 9, 9)
+	assert.Equal(t, ErrNotSquare, view.T())
+}
+
+func TestViewGaussianElimination(t *testing.T) {
+	tests := []struct {
+		description string
+		matrix      *F2
+		startRow    int
+		startCol    int
+		stopRow     int
+		stopCol     int
+	}{
+		{
+			description: "square window",
+			matrix:      randomF2(20, 30, 2),
+			startRow:    3,
+			startCol:    5,
+			stopRow:     19,
+			stopCol:     21,
+		},
+		{
+			description: "wide window at the bottom",
+			matrix:      randomF2(20, 30, 3),
+			startRow:    15,
+			startCol:    0,
+			stopRow:     20,
+			stopCol:     10,
+		},
+		{
+			description: "tall window",
+			matrix:      randomF2(20, 30, 4),
+			startRow:    2,
+			startCol:    7,
+			stopRow:     18,
+			stopCol:     12,
+		},
+		{
+			description: "wide window over the full matrix",
+			matrix:      randomF2(6, 40, 5),
+			startRow:    0,
+			startCol:    0,
+			stopRow:     6,
+			stopCol:     40,
+		},
+	}
+
+	for _, test := range tests {
+		saved := test.matrix.Clone()
+
+		view, err := test.matrix.View(test.startRow, test.startCol, test.stopRow, test.stopCol)
+		assert.Nilf(t, err, test.description)
+		assert.Nilf(t, view.GaussianElimination(), test.description)
+
+		// the rows outside of the window are not modified
+		for i := range saved.Rows {
+			if i < test.startRow || i >= test.stopRow {
+				assert.Zerof(t, saved.Rows[i].Cmp(test.matrix.Rows[i]), test.description)
+			}
+		}
+
+		// the rows of the window span the same space as before
+		before := saved.GetSubMatrix(test.startRow, 0, test.stopRow, saved.M)
+		after := test.matrix.GetSubMatrix(test.startRow, 0, test.stopRow, saved.M)
+		both, _ := VConcat(before, after)
+		assert.Equalf(t, before.Rank(), both.Rank(), test.description)
+		assert.Equalf(t, after.Rank(), both.Rank(), test.description)
+
+		// each column with a pivot bit only contains the pivot bit
+		for k := 0; k < view.N && k < view.M; k++ {
+			if value, _ := view.At(k, k); value == 0 {
+				continue
+			}
+
+			for i := 0; i < view.N; i++ {
+				value, _ := view.At(i, k)
+				assert.Equalf(t, i == k, value == 1, test.description)
+			}
+		}
+	}
+}
+
+func TestViewGaussianEliminationWideWindow(t *testing.T) {
+	matrix := NewF2(2, 3).Set([]*big.Int{
+		big.NewInt(7),
+		big.NewInt(6),
+	})
+
+	// only the rows of the window are pivot rows
+	expected := NewF2(2, 3).Set([]*big.Int{
+		big.NewInt(1),
+		big.NewInt(6),
+	})
+
+	view, _ := matrix.View(0, 0, 2, 3)
+	assert.Nil(t, view.GaussianElimination())
+	assert.True(t, matrix.IsEqual(expected))
+
+	// the partial elimination keeps using the rows below the range
+	matrix = NewF2(3, 3).Set([]*big.Int{
+		big.NewInt(5),
+		big.NewInt(2),
+		big.NewInt(7),
+	})
+
+	expected = NewF2(3, 3).Set([]*big.Int{
+		big.NewInt(0),
+		big.NewInt(2),
+		big.NewInt(7),
+	})
+
+	assert.Nil(t, matrix.PartialGaussianElimination(0, 0, 1, 2))
+	assert.True(t, matrix.IsEqual(expected))
+	assert.Equal(t, ErrIndexOutOfRange, NewF2(2, 3).PartialGaussianElimination(0, 0, 1, 2))
+}